@@ -11,7 +11,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.24.0
 	github.com/aws/aws-sdk-go v1.55.6
-	github.com/aws/aws-sdk-go-v2 v1.36.3
+	github.com/aws/aws-sdk-go-v2 v1.36.5
 	github.com/aws/aws-sdk-go-v2/config v1.29.13
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.66
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.30
@@ -100,7 +100,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdb v1.41.2
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.15.2
 	github.com/aws/aws-sdk-go-v2/service/drs v1.31.2
-	github.com/aws/aws-sdk-go-v2/service/dsql v1.1.2
+	github.com/aws/aws-sdk-go-v2/service/dsql v1.5.0
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.42.1
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.211.1
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.43.2
@@ -127,7 +127,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/gamelift v1.41.1
 	github.com/aws/aws-sdk-go-v2/service/glacier v1.27.3
 	github.com/aws/aws-sdk-go-v2/service/globalaccelerator v1.30.2
-	github.com/aws/aws-sdk-go-v2/service/glue v1.107.1
+	github.com/aws/aws-sdk-go-v2/service/glue v1.116.0
 	github.com/aws/aws-sdk-go-v2/service/grafana v1.27.2
 	github.com/aws/aws-sdk-go-v2/service/greengrass v1.28.2
 	github.com/aws/aws-sdk-go-v2/service/groundstation v1.32.2
@@ -178,7 +178,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mwaa v1.34.2
 	github.com/aws/aws-sdk-go-v2/service/neptune v1.36.2
 	github.com/aws/aws-sdk-go-v2/service/neptunegraph v1.17.3
-	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.47.2
+	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.50.0
 	github.com/aws/aws-sdk-go-v2/service/networkmanager v1.34.1
 	github.com/aws/aws-sdk-go-v2/service/networkmonitor v1.8.2
 	github.com/aws/aws-sdk-go-v2/service/oam v1.17.3
@@ -267,7 +267,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.55.2
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.27.2
 	github.com/aws/aws-sdk-go-v2/service/xray v1.31.2
-	github.com/aws/smithy-go v1.22.3
+	github.com/aws/smithy-go v1.22.4
 	github.com/beevik/etree v1.5.0
 	github.com/cedar-policy/cedar-go v0.1.0
 	github.com/davecgh/go-spew v1.1.1
@@ -323,8 +323,8 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.10 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.34 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.36 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.36 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.3 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.34 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.3 // indirect
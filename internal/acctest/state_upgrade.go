@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// StateUpgradeTest describes a single Plugin Framework resource state upgrade test.
+// Prior and expected state are loaded from golden JSON files, typically under the
+// service package's testdata directory.
+type StateUpgradeTest struct {
+	// Name is the name of the subtest.
+	Name string
+	// Version is the schema version of the prior state.
+	Version int64
+	// PriorStateFile is the path of a JSON file containing the raw prior state.
+	PriorStateFile string
+	// ExpectedStateFile is the path of a JSON file containing the expected current state.
+	// Attributes omitted from the file are expected to be null.
+	ExpectedStateFile string
+	// ExpectError, if set, is matched against the upgrade's error diagnostics.
+	ExpectError *regexp.Regexp
+}
+
+// RunStateUpgradeTests runs the resource's state upgraders against golden prior-version state
+// and compares the results to the expected current state.
+// No AWS API calls are made; the resource is not configured.
+func RunStateUpgradeTests(ctx context.Context, t *testing.T, r fwresource.ResourceWithUpgradeState, tests ...StateUpgradeTest) {
+	t.Helper()
+
+	var schemaResponse fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("reading resource schema: %s", fwdiag.DiagnosticsError(schemaResponse.Diagnostics))
+	}
+	currentSchema := schemaResponse.Schema
+	typ := currentSchema.Type().TerraformType(ctx)
+
+	upgraders := r.UpgradeState(ctx)
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			upgrader, ok := upgraders[test.Version]
+			if !ok {
+				t.Fatalf("no state upgrader for schema version %d", test.Version)
+			}
+
+			prior, err := os.ReadFile(test.PriorStateFile)
+			if err != nil {
+				t.Fatalf("reading prior state: %s", err)
+			}
+
+			request := fwresource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{
+					JSON: prior,
+				},
+			}
+
+			// Mirror the framework server: when a prior schema is declared the raw state is decoded with it.
+			if upgrader.PriorSchema != nil {
+				priorValue, err := request.RawState.UnmarshalWithOpts(upgrader.PriorSchema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+					ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+						IgnoreUndefinedAttributes: true,
+					},
+				})
+				if err != nil {
+					t.Fatalf("decoding prior state with prior schema: %s", err)
+				}
+
+				request.State = &tfsdk.State{
+					Raw:    priorValue,
+					Schema: *upgrader.PriorSchema,
+				}
+			}
+
+			response := fwresource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(typ, nil),
+					Schema: currentSchema,
+				},
+			}
+
+			upgrader.StateUpgrader(ctx, request, &response)
+
+			if test.ExpectError != nil {
+				if !response.Diagnostics.HasError() {
+					t.Fatalf("expected error matching %q, got none", test.ExpectError)
+				}
+				if err := fwdiag.DiagnosticsError(response.Diagnostics); !test.ExpectError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %s", test.ExpectError, err)
+				}
+				return
+			}
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("upgrading state: %s", fwdiag.DiagnosticsError(response.Diagnostics))
+			}
+
+			got := response.State.Raw
+			if response.DynamicValue != nil {
+				got, err = response.DynamicValue.Unmarshal(typ)
+				if err != nil {
+					t.Fatalf("decoding upgraded state with current schema: %s", err)
+				}
+			}
+
+			expected, err := os.ReadFile(test.ExpectedStateFile)
+			if err != nil {
+				t.Fatalf("reading expected state: %s", err)
+			}
+
+			want, err := (&tfprotov6.DynamicValue{JSON: expected}).Unmarshal(typ)
+			if err != nil {
+				t.Fatalf("decoding expected state with current schema: %s", err)
+			}
+
+			diffs, err := got.Diff(want)
+			if err != nil {
+				t.Fatalf("comparing states: %s", err)
+			}
+
+			for _, diff := range diffs {
+				t.Errorf("unexpected upgraded state: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type stateUpgradeTestResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpRead
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+}
+
+func (r *stateUpgradeTestResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Version: 2,
+		Attributes: map[string]schema.Attribute{
+			names.AttrEnabled: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *stateUpgradeTestResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+}
+
+func (r *stateUpgradeTestResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return framework.StateUpgraders(2,
+		framework.StateVersion{
+			Version: 0,
+			Upgrade: func(ctx context.Context, state framework.RawState) (framework.RawState, diag.Diagnostics) {
+				var diags diag.Diagnostics
+
+				label, ok := state["label"]
+				if !ok {
+					diags.AddError("Upgrading resource state", fmt.Sprintf("missing %q", "label"))
+					return nil, diags
+				}
+				delete(state, "label")
+				state[names.AttrName] = label
+
+				return state, diags
+			},
+		},
+		framework.StateVersion{
+			Version: 1,
+			Upgrade: func(ctx context.Context, state framework.RawState) (framework.RawState, diag.Diagnostics) {
+				state[names.AttrEnabled] = false
+
+				return state, nil
+			},
+		},
+	)
+}
+
+func TestRunStateUpgradeTests(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	acctest.RunStateUpgradeTests(ctx, t, &stateUpgradeTestResource{},
+		acctest.StateUpgradeTest{
+			Name:              "v0",
+			Version:           0,
+			PriorStateFile:    "testdata/state_upgrade/v0.json",
+			ExpectedStateFile: "testdata/state_upgrade/v2.json",
+		},
+		acctest.StateUpgradeTest{
+			Name:              "v1",
+			Version:           1,
+			PriorStateFile:    "testdata/state_upgrade/v1.json",
+			ExpectedStateFile: "testdata/state_upgrade/v2.json",
+		},
+		acctest.StateUpgradeTest{
+			Name:           "v0 invalid",
+			Version:        0,
+			PriorStateFile: "testdata/state_upgrade/v0_invalid.json",
+			ExpectError:    regexache.MustCompile(`missing "label"`),
+		},
+	)
+}
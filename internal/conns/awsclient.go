@@ -71,7 +71,13 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the provider's default tags configuration.
+// Within a resource's Context the configuration applicable to the resource's type is returned.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := FromContext(ctx); ok && !inContext.IsDataSource() && !inContext.IsEphemeralResource() {
+		return c.defaultTagsConfig.ForResourceType(inContext.TypeName())
+	}
+
 	return c.defaultTagsConfig
 }
 
@@ -26,6 +26,13 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithTagPropagations is an interface that extends ServicePackage with tag propagations.
+// Tag propagations declare the child resources that AWS creates on behalf of the service package's resources.
+type ServicePackageWithTagPropagations interface {
+	ServicePackage
+	TagPropagations(context.Context) []*types.ServicePackageTagPropagation
+}
+
 type (
 	contextKeyType int
 )
@@ -40,6 +47,7 @@ type InContext struct {
 	isEphemeralResource bool   // Ephemeral resource?
 	resourceName        string // Friendly resource name, e.g. "Subnet"
 	servicePackageName  string // Canonical name defined as a constant in names package
+	typeName            string // Terraform type name, e.g. "aws_subnet"
 }
 
 // IsDataSource returns true if the resource is a data source.
@@ -57,35 +65,43 @@ func (c *InContext) ResourceName() string {
 	return c.resourceName
 }
 
+// TypeName returns the Terraform type name, e.g. "aws_subnet".
+func (c *InContext) TypeName() string {
+	return c.typeName
+}
+
 // ServicePackageName returns the canonical service name defined as a constant in the `names` package.
 func (c *InContext) ServicePackageName() string {
 	return c.servicePackageName
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		isDataSource:       true,
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		isEphemeralResource: true,
 		resourceName:        resourceName,
 		servicePackageName:  servicePackageName,
+		typeName:            typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		resourceName:       resourceName,
 		servicePackageName: servicePackageName,
+		typeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
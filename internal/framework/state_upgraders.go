@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RawState is a resource's state as decoded from its stored JSON representation.
+// Numbers are decoded as json.Number so that large integers keep their precision.
+type RawState = map[string]any
+
+// RawStateUpgradeFunc transforms raw state from one schema version to the next.
+type RawStateUpgradeFunc func(ctx context.Context, state RawState) (RawState, diag.Diagnostics)
+
+// StateVersion is a prior schema version of a resource, along with the function
+// that upgrades raw state at that version to the following version.
+type StateVersion struct {
+	// Version is the prior schema version.
+	Version int64
+	// Upgrade transforms raw state at Version to raw state at Version+1.
+	Upgrade RawStateUpgradeFunc
+}
+
+// StateUpgraders returns the resource.StateUpgrader map for a resource whose current schema
+// version is currentVersion and which has the specified prior versions.
+// Each upgrader decodes the stored raw state, runs every per-version transform from its
+// version up to the current version and returns the result, so resources only ever
+// describe a single step between adjacent versions.
+// Intended to be called from a resource's UpgradeState method.
+func StateUpgraders(currentVersion int64, versions ...StateVersion) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(versions))
+
+	for _, v := range versions {
+		upgraders[v.Version] = resource.StateUpgrader{
+			// A nil PriorSchema causes the framework to pass the raw state JSON.
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				if request.RawState == nil || request.RawState.JSON == nil {
+					response.Diagnostics.AddError("Upgrading resource state", "Raw state JSON is missing")
+					return
+				}
+
+				state, err := decodeRawState(request.RawState.JSON)
+				if err != nil {
+					response.Diagnostics.AddError("Upgrading resource state", fmt.Sprintf("decoding raw state: %s", err))
+					return
+				}
+
+				state, diags := UpgradeRawState(ctx, state, v.Version, currentVersion, versions...)
+				response.Diagnostics.Append(diags...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				data, err := json.Marshal(state)
+				if err != nil {
+					response.Diagnostics.AddError("Upgrading resource state", fmt.Sprintf("encoding raw state: %s", err))
+					return
+				}
+
+				response.DynamicValue = &tfprotov6.DynamicValue{
+					JSON: data,
+				}
+			},
+		}
+	}
+
+	return upgraders
+}
+
+// decodeRawState decodes stored state JSON, preserving numbers as json.Number.
+func decodeRawState(data []byte) (RawState, error) {
+	var state RawState
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&state); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// UpgradeRawState runs the chain of per-version transforms on raw state at version from,
+// returning raw state at version to.
+func UpgradeRawState(ctx context.Context, state RawState, from, to int64, versions ...StateVersion) (RawState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	versions = slices.Clone(versions)
+	slices.SortFunc(versions, func(a, b StateVersion) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	for version := from; version < to; version++ {
+		i := slices.IndexFunc(versions, func(v StateVersion) bool {
+			return v.Version == version
+		})
+
+		if i == -1 || versions[i].Upgrade == nil {
+			diags.AddError("Upgrading resource state", fmt.Sprintf("no upgrade defined from schema version %d", version))
+			return nil, diags
+		}
+
+		tflog.Debug(ctx, "Upgrading resource state", map[string]any{
+			"from_version": version,
+			"to_version":   version + 1,
+		})
+
+		var d diag.Diagnostics
+		state, d = versions[i].Upgrade(ctx, state)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return state, diags
+}
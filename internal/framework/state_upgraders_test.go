@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestUpgradeRawState(t *testing.T) {
+	t.Parallel()
+
+	// Versions are deliberately out of order.
+	versions := []StateVersion{
+		{
+			Version: 1,
+			Upgrade: func(ctx context.Context, state RawState) (RawState, diag.Diagnostics) {
+				state["steps"] = append(state["steps"].([]any), "1->2")
+				return state, nil
+			},
+		},
+		{
+			Version: 0,
+			Upgrade: func(ctx context.Context, state RawState) (RawState, diag.Diagnostics) {
+				state["steps"] = append(state["steps"].([]any), "0->1")
+				return state, nil
+			},
+		},
+		{
+			Version: 2,
+			Upgrade: func(ctx context.Context, state RawState) (RawState, diag.Diagnostics) {
+				var diags diag.Diagnostics
+				diags.AddError("test", "upgrade failed")
+				return nil, diags
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		from, to      int64
+		expectedSteps []any
+		expectError   bool
+	}{
+		"no upgrade": {
+			from:          1,
+			to:            1,
+			expectedSteps: []any{},
+		},
+		"single step": {
+			from:          1,
+			to:            2,
+			expectedSteps: []any{"1->2"},
+		},
+		"chain": {
+			from:          0,
+			to:            2,
+			expectedSteps: []any{"0->1", "1->2"},
+		},
+		"upgrade error": {
+			from:        0,
+			to:          3,
+			expectError: true,
+		},
+		"missing version": {
+			from:        2,
+			to:          4,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := RawState{
+				"steps": []any{},
+			}
+
+			got, diags := UpgradeRawState(context.Background(), state, testCase.from, testCase.to, versions...)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("UpgradeRawState() error = %t, want %t: %v", got, want, diags)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(got["steps"], testCase.expectedSteps); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateUpgradersPreservesNumbers(t *testing.T) {
+	t.Parallel()
+
+	upgraders := StateUpgraders(1, StateVersion{
+		Version: 0,
+		Upgrade: func(ctx context.Context, state RawState) (RawState, diag.Diagnostics) {
+			state["new_id"] = state[names.AttrID]
+			return state, nil
+		},
+	})
+
+	// 2^53 + 1 can't be represented exactly as a float64.
+	request := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: []byte(`{"id":9007199254740993,"ratio":0.25}`),
+		},
+	}
+	var response resource.UpgradeStateResponse
+
+	upgraders[0].StateUpgrader(context.Background(), request, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", response.Diagnostics)
+	}
+
+	var got map[string]json.RawMessage
+	if err := json.Unmarshal(response.DynamicValue.JSON, &got); err != nil {
+		t.Fatalf("decoding upgraded state: %s", err)
+	}
+
+	want := map[string]json.RawMessage{
+		names.AttrID: json.RawMessage(`9007199254740993`),
+		"new_id":     json.RawMessage(`9007199254740993`),
+		"ratio":      json.RawMessage(`0.25`),
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
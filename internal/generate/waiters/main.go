@@ -0,0 +1,449 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/constant"
+	"go/types"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	defaultInputFilename  = `waiters.hcl`
+	defaultOutputFilename = `waiters_gen.go`
+)
+
+var (
+	inputFilename = flag.String("Input", defaultInputFilename, "name of the waiter description file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-waiters-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// Description is the declarative description of a service package's status and wait functions.
+type Description struct {
+	Statuses []Status `hcl:"status,block"`
+	Waiters  []Waiter `hcl:"waiter,block"`
+}
+
+// Status describes a status function.
+type Status struct {
+	Name              string   `hcl:"name,label"`
+	Arguments         []string `hcl:"arguments"`
+	FailureReasonPath string   `hcl:"failure_reason_path,optional"`
+	Finder            string   `hcl:"finder"`
+	Output            string   `hcl:"output"`
+	Path              string   `hcl:"path"`
+}
+
+// Waiter describes a wait function.
+type Waiter struct {
+	Name                      string   `hcl:"name,label"`
+	ContinuousTargetOccurence int      `hcl:"continuous_target_occurence,optional"`
+	Delay                     string   `hcl:"delay,optional"`
+	Failure                   []string `hcl:"failure,optional"`
+	MinTimeout                string   `hcl:"min_timeout,optional"`
+	NotFoundChecks            int      `hcl:"not_found_checks,optional"`
+	Pending                   []string `hcl:"pending"`
+	PollInterval              string   `hcl:"poll_interval,optional"`
+	Status                    string   `hcl:"status"`
+	Target                    []string `hcl:"target"`
+}
+
+type TemplateData struct {
+	Parameters      string
+	ProviderPackage string
+	AWSService      string
+	Statuses        []StatusDatum
+	Waiters         []WaiterDatum
+}
+
+type StatusDatum struct {
+	Name       string
+	Arguments  []Argument
+	Finder     string
+	OutputType string
+	StatusPath PathCode
+	ReasonPath PathCode
+}
+
+type WaiterDatum struct {
+	Name                      string
+	Status                    StatusDatum
+	ContinuousTargetOccurence int
+	Delay                     string
+	Failure                   string
+	MinTimeout                string
+	NotFoundChecks            int
+	Pending                   string
+	PollInterval              string
+	Target                    string
+}
+
+type Argument struct {
+	Name string
+	Type string
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	flag.Usage = usage
+	flag.Parse()
+
+	filename := defaultOutputFilename
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+
+	description, err := readDescription(*inputFilename)
+	if err != nil {
+		g.Fatalf("reading %s: %s", *inputFilename, err)
+	}
+
+	awsService := service.GoV2Package()
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s", awsService)
+
+	sdk, err := loadSDK(sourcePackage)
+	if err != nil {
+		g.Fatalf("loading %s: %s", sourcePackage, err)
+	}
+
+	td := TemplateData{
+		Parameters:      strings.Join(os.Args[1:], " "),
+		ProviderPackage: servicePackage,
+		AWSService:      awsService,
+	}
+
+	statuses := make(map[string]StatusDatum)
+	enums := make(map[string]*types.Named)
+
+	for _, v := range description.Statuses {
+		datum, enum, err := sdk.status(v)
+		if err != nil {
+			g.Fatalf("status %q: %s", v.Name, err)
+		}
+
+		statuses[v.Name] = datum
+		enums[v.Name] = enum
+		td.Statuses = append(td.Statuses, datum)
+	}
+
+	for _, v := range description.Waiters {
+		status, ok := statuses[v.Status]
+		if !ok {
+			g.Fatalf("waiter %q: status %q not found", v.Name, v.Status)
+		}
+
+		datum := WaiterDatum{
+			Name:                      v.Name,
+			Status:                    status,
+			ContinuousTargetOccurence: v.ContinuousTargetOccurence,
+			NotFoundChecks:            v.NotFoundChecks,
+		}
+
+		for _, d := range []struct {
+			in  string
+			out *string
+		}{
+			{v.Delay, &datum.Delay},
+			{v.MinTimeout, &datum.MinTimeout},
+			{v.PollInterval, &datum.PollInterval},
+		} {
+			if d.in == "" {
+				continue
+			}
+			if *d.out, err = durationCode(d.in); err != nil {
+				g.Fatalf("waiter %q: %s", v.Name, err)
+			}
+		}
+
+		enum := enums[v.Status]
+		if datum.Pending, err = sdk.statesCode(enum, v.Pending); err != nil {
+			g.Fatalf("waiter %q: pending: %s", v.Name, err)
+		}
+		if datum.Target, err = sdk.statesCode(enum, v.Target); err != nil {
+			g.Fatalf("waiter %q: target: %s", v.Name, err)
+		}
+		if len(v.Failure) > 0 {
+			if datum.Failure, err = sdk.statesCode(enum, v.Failure); err != nil {
+				g.Fatalf("waiter %q: failure: %s", v.Name, err)
+			}
+		}
+
+		td.Waiters = append(td.Waiters, datum)
+	}
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("waiters", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+func readDescription(filename string) (*Description, error) {
+	parser := hclparse.NewParser()
+
+	file, diags := parser.ParseHCLFile(filename)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	var description Description
+	if diags := gohcl.DecodeBody(file.Body, nil, &description); diags.HasErrors() {
+		return nil, diags
+	}
+
+	return &description, nil
+}
+
+type sdkPackages struct {
+	api   *types.Package
+	types *types.Package
+}
+
+func loadSDK(sourcePackage string) (*sdkPackages, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedSyntax,
+	}
+
+	typesPackage := sourcePackage + "/types"
+	pkgs, err := packages.Load(cfg, sourcePackage, typesPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	var sdk sdkPackages
+	for _, pkg := range pkgs {
+		for _, err := range pkg.Errors {
+			return nil, err
+		}
+
+		switch pkg.PkgPath {
+		case sourcePackage:
+			sdk.api = pkg.Types
+		case typesPackage:
+			sdk.types = pkg.Types
+		}
+	}
+
+	if sdk.api == nil || sdk.types == nil {
+		return nil, fmt.Errorf("%d packages found", len(pkgs))
+	}
+
+	return &sdk, nil
+}
+
+// status resolves a status description against the AWS SDK's types.
+// The status path's enum type, if any, is returned so that waiter states can refer to its values.
+func (sdk *sdkPackages) status(v Status) (StatusDatum, *types.Named, error) {
+	datum := StatusDatum{
+		Name:   v.Name,
+		Finder: v.Finder,
+	}
+
+	for _, arg := range v.Arguments {
+		name, typ, ok := strings.Cut(arg, " ")
+		if !ok {
+			return datum, nil, fmt.Errorf("argument %q must be of the form \"<name> <type>\"", arg)
+		}
+		datum.Arguments = append(datum.Arguments, Argument{Name: name, Type: strings.TrimSpace(typ)})
+	}
+
+	var (
+		obj  types.Object
+		code string
+	)
+	if name, ok := strings.CutPrefix(v.Output, "types."); ok {
+		obj = sdk.types.Scope().Lookup(name)
+		code = "awstypes." + name
+	} else {
+		obj = sdk.api.Scope().Lookup(v.Output)
+		code = sdk.api.Name() + "." + v.Output
+	}
+	if obj == nil {
+		return datum, nil, fmt.Errorf("output type %q not found", v.Output)
+	}
+	datum.OutputType = code
+
+	typ := obj.Type()
+
+	status, enum, err := pathCode(typ, v.Path)
+	if err != nil {
+		return datum, nil, fmt.Errorf("path: %w", err)
+	}
+	datum.StatusPath = status
+
+	if v.FailureReasonPath != "" {
+		reason, _, err := pathCode(typ, v.FailureReasonPath)
+		if err != nil {
+			return datum, nil, fmt.Errorf("failure_reason_path: %w", err)
+		}
+		datum.ReasonPath = reason
+	}
+
+	return datum, enum, nil
+}
+
+// PathCode is Go code that evaluates the string value at a field path within a value named "output".
+type PathCode struct {
+	// Guard is the nil-check condition for any intermediate pointer fields, if any.
+	Guard string
+	// Value is the string-valued expression.
+	Value string
+}
+
+// pathCode returns Go code for the string value at the dot-separated field path
+// within a (pointer to a) typ value.
+func pathCode(typ types.Type, path string) (PathCode, *types.Named, error) {
+	var (
+		expr   = "output"
+		guards []string
+	)
+
+	for _, field := range strings.Split(path, ".") {
+		if ptr, ok := typ.(*types.Pointer); ok {
+			// The root value is never nil.
+			if expr != "output" {
+				guards = append(guards, expr+" != nil")
+			}
+			typ = ptr.Elem()
+		}
+
+		st, ok := typ.Underlying().(*types.Struct)
+		if !ok {
+			return PathCode{}, nil, fmt.Errorf("%s is not a struct", expr)
+		}
+
+		var f *types.Var
+		for i := range st.NumFields() {
+			if v := st.Field(i); v.Name() == field {
+				f = v
+				break
+			}
+		}
+		if f == nil {
+			return PathCode{}, nil, fmt.Errorf("%s has no field %q", expr, field)
+		}
+
+		expr = expr + "." + field
+		typ = f.Type()
+	}
+
+	var (
+		value string
+		enum  *types.Named
+	)
+	switch t := typ.(type) {
+	case *types.Pointer:
+		if basic, ok := t.Elem().(*types.Basic); ok && basic.Kind() == types.String {
+			value = fmt.Sprintf("aws.ToString(%s)", expr)
+		}
+	case *types.Named:
+		if basic, ok := t.Underlying().(*types.Basic); ok && basic.Kind() == types.String {
+			value = fmt.Sprintf("string(%s)", expr)
+			enum = t
+		}
+	case *types.Basic:
+		if t.Kind() == types.String {
+			value = expr
+		}
+	}
+	if value == "" {
+		return PathCode{}, nil, fmt.Errorf("%s (%s) is not a string", expr, typ)
+	}
+
+	return PathCode{
+		Guard: strings.Join(guards, " && "),
+		Value: value,
+	}, enum, nil
+}
+
+// statesCode returns Go code for a []string of states.
+// States of enum type are expressed using the AWS SDK's enum constants.
+func (sdk *sdkPackages) statesCode(enum *types.Named, states []string) (string, error) {
+	if len(states) == 0 {
+		return "[]string{}", nil
+	}
+
+	if enum == nil {
+		var quoted []string
+		for _, state := range states {
+			quoted = append(quoted, fmt.Sprintf("%q", state))
+		}
+
+		return fmt.Sprintf("[]string{%s}", strings.Join(quoted, ", ")), nil
+	}
+
+	values := make(map[string]string)
+	scope := enum.Obj().Pkg().Scope()
+	for _, name := range scope.Names() {
+		if c, ok := scope.Lookup(name).(*types.Const); ok && types.Identical(c.Type(), enum) {
+			values[constant.StringVal(c.Val())] = name
+		}
+	}
+
+	var names []string
+	for _, state := range states {
+		name, ok := values[state]
+		if !ok {
+			return "", fmt.Errorf("%q is not a value of %s", state, enum.Obj().Name())
+		}
+		names = append(names, "awstypes."+name)
+	}
+	slices.Sort(names)
+
+	return fmt.Sprintf("enum.Slice(%s)", strings.Join(names, ", ")), nil
+}
+
+func durationCode(s string) (string, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute), nil
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second), nil
+	default:
+		return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond), nil
+	}
+}
+
+//go:embed file.gtpl
+var tmpl string
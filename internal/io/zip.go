@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+var (
+	// zipModTime is the modification time recorded for every entry in a reproducible ZIP archive.
+	// It's the earliest time representable in the MS-DOS date and time format.
+	zipModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// ZipDirectory creates a reproducible ZIP archive of the regular files in a directory.
+// Files are included if their slash-separated path relative to the directory matches any of
+// the include patterns (or there are no include patterns) and doesn't match any of the exclude patterns.
+// Patterns support `*` (any sequence of characters other than `/`), `?` (any single character other than `/`)
+// and `**` (any sequence of characters, including `/`).
+// Entries are sorted by path, have a fixed modification time and have normalized permissions (0644, or 0755 if executable),
+// so that the archive's contents depend only on the contents of the included files.
+// Usually a call to this function is protected by an exclusive lock (per resource type)
+// to prevent memory exhaustion (e.g. `conns.GlobalMutexKV.Lock`).
+func ZipDirectory(v string, includes, excludes []string) ([]byte, error) {
+	dir, err := homedir.Expand(v)
+	if err != nil {
+		return nil, err
+	}
+
+	includeREs, err := compileGlobs(includes)
+	if err != nil {
+		return nil, err
+	}
+	excludeREs, err := compileGlobs(excludes)
+	if err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		name string
+		path string
+		mode fs.FileMode
+	}
+	var entries []entry
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path == dir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if matchAny(excludeREs, name) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		// Follow symbolic links to files. Symbolic links to directories are ignored.
+		fi, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		if len(includeREs) > 0 && !matchAny(includeREs, name) {
+			return nil
+		}
+		if matchAny(excludeREs, name) {
+			return nil
+		}
+
+		mode := fs.FileMode(0o644)
+		if fi.Mode().Perm()&0o111 != 0 {
+			mode = 0o755
+		}
+		entries = append(entries, entry{name: name, path: path, mode: mode})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no files in directory (%s) match the include and exclude patterns", v)
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, entry := range entries {
+		header := &zip.FileHeader{
+			Name:     entry.name,
+			Method:   zip.Deflate,
+			Modified: zipModTime,
+		}
+		header.SetMode(entry.mode)
+
+		if err := addZipEntry(w, header, entry.path); err != nil {
+			return nil, fmt.Errorf("adding %s to ZIP archive: %w", entry.name, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func addZipEntry(w *zip.Writer, header *zip.FileHeader, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fw, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(fw, f)
+
+	return err
+}
+
+func compileGlobs(patterns []string) ([]*regexp.Regexp, error) {
+	var errs []error
+	res := make([]*regexp.Regexp, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		re, err := globToRegexp(pattern)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid pattern (%s): %w", pattern, err))
+			continue
+		}
+		res = append(res, re)
+	}
+
+	return res, errors.Join(errs...)
+}
+
+// globToRegexp converts a slash-separated glob pattern to an anchored regular expression.
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+
+	// Iterate over runes, not bytes, so that non-ASCII literals are quoted intact.
+	runes := []rune(pattern)
+
+	sb.WriteString("^")
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' {
+				i++
+				if i+1 < len(runes) && runes[i+1] == '/' {
+					// "**/" matches zero or more directories.
+					i++
+					sb.WriteString("(?:.*/)?")
+				} else {
+					sb.WriteString(".*")
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
+
+func matchAny(res []*regexp.Regexp, name string) bool {
+	return slices.ContainsFunc(res, func(re *regexp.Regexp) bool {
+		return re.MatchString(name)
+	})
+}
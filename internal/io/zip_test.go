@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestZipDirectory(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		includes      []string
+		excludes      []string
+		expectedNames []string
+		expectedErr   bool
+	}{
+		"all files": {
+			expectedNames: []string{"README.md", "bin/run.sh", "index.py", "lib/util.py", "tests/test_index.py"},
+		},
+		"includes": {
+			includes:      []string{"**/*.py"},
+			expectedNames: []string{"index.py", "lib/util.py", "tests/test_index.py"},
+		},
+		"includes single segment": {
+			includes:      []string{"*.py"},
+			expectedNames: []string{"index.py"},
+		},
+		"excludes": {
+			excludes:      []string{"tests", "*.md"},
+			expectedNames: []string{"bin/run.sh", "index.py", "lib/util.py"},
+		},
+		"includes and excludes": {
+			includes:      []string{"**/*.py"},
+			excludes:      []string{"tests/**"},
+			expectedNames: []string{"index.py", "lib/util.py"},
+		},
+		"no matches": {
+			includes:    []string{"*.js"},
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := writeTestDirectory(t)
+
+			output, err := ZipDirectory(dir, testCase.includes, testCase.excludes)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("ZipDirectory() err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			r, err := zip.NewReader(bytes.NewReader(output), int64(len(output)))
+			if err != nil {
+				t.Fatalf("reading ZIP archive: %s", err)
+			}
+
+			var names []string
+			for _, f := range r.File {
+				names = append(names, f.Name)
+
+				if got, want := f.Modified.UTC(), zipModTime; !got.Equal(want) {
+					t.Errorf("%s modified = %s, want %s", f.Name, got, want)
+				}
+
+				want := os.FileMode(0o644)
+				if f.Name == "bin/run.sh" {
+					want = 0o755
+				}
+				if got := f.Mode().Perm(); got != want {
+					t.Errorf("%s mode = %s, want %s", f.Name, got, want)
+				}
+			}
+
+			if got, want := names, testCase.expectedNames; !slices.Equal(got, want) {
+				t.Errorf("names = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestGlobToRegexp(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern  string
+		matches  []string
+		excludes []string
+	}{
+		"single segment": {
+			pattern:  "*.py",
+			matches:  []string{"index.py"},
+			excludes: []string{"lib/util.py", "index.pyc"},
+		},
+		"any depth": {
+			pattern:  "**/*.py",
+			matches:  []string{"index.py", "lib/util.py", "a/b/c.py"},
+			excludes: []string{"index.js"},
+		},
+		"single character": {
+			pattern:  "?.txt",
+			matches:  []string{"a.txt"},
+			excludes: []string{"ab.txt", "/.txt"},
+		},
+		"metacharacters": {
+			pattern:  "a+b(1).txt",
+			matches:  []string{"a+b(1).txt"},
+			excludes: []string{"aab1.txt"},
+		},
+		"non-ASCII": {
+			pattern:  "données/**/résumé-*.md",
+			matches:  []string{"données/résumé-2024.md", "données/archive/résumé-2023.md"},
+			excludes: []string{"donnees/resume-2024.md", "données/résumé.md"},
+		},
+		"non-ASCII single character": {
+			pattern:  "?.txt",
+			matches:  []string{"é.txt", "日.txt"},
+			excludes: []string{"éé.txt"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			re, err := globToRegexp(testCase.pattern)
+			if err != nil {
+				t.Fatalf("globToRegexp(%q): %s", testCase.pattern, err)
+			}
+
+			for _, v := range testCase.matches {
+				if !re.MatchString(v) {
+					t.Errorf("%q doesn't match %q", testCase.pattern, v)
+				}
+			}
+			for _, v := range testCase.excludes {
+				if re.MatchString(v) {
+					t.Errorf("%q matches %q", testCase.pattern, v)
+				}
+			}
+		})
+	}
+}
+
+func TestZipDirectoryReproducible(t *testing.T) {
+	t.Parallel()
+
+	dir1, dir2 := writeTestDirectory(t), writeTestDirectory(t)
+
+	// Different modification times and permissions must not change the archive.
+	mtime := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir2, "index.py"), mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(filepath.Join(dir2, "README.md"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	output1, err := ZipDirectory(dir1, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output2, err := ZipDirectory(dir2, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(output1, output2) {
+		t.Error("ZIP archives differ")
+	}
+
+	// Different contents must change the archive.
+	if err := os.WriteFile(filepath.Join(dir2, "index.py"), []byte("print('changed')\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	output2, err = ZipDirectory(dir2, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(output1, output2) {
+		t.Error("ZIP archives are equal")
+	}
+}
+
+func writeTestDirectory(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, mode := range map[string]os.FileMode{
+		"README.md":           0o644,
+		"bin/run.sh":          0o750,
+		"index.py":            0o644,
+		"lib/util.py":         0o664,
+		"tests/test_index.py": 0o644,
+	} {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(name+"\n"), mode); err != nil {
+			t.Fatal(err)
+		}
+		// WriteFile's permissions are subject to umask.
+		if err := os.Chmod(path, mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
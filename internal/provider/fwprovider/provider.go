@@ -261,6 +261,21 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type name patterns, e.g. `aws_autoscaling_group`, that `tags` are not defaulted across.",
+						},
+						"include_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type name patterns, e.g. `aws_s3_*`, that `tags` are defaulted across. Defaults to all resource types.",
+						},
+						"propagate_to_child_resources": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type name patterns, e.g. `aws_instance`, whose resources' AWS-created child resources are also tagged with default tags.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -268,6 +283,25 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"resource_type_tags": schema.ListNestedBlock{
+							Description: "Configuration block with additional resource tags to default across resources of matching types.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource type name patterns, e.g. `aws_s3_bucket*`.",
+									},
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "Resource tags to default across resources of matching types.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -284,6 +318,16 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_values": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tags to ignore across all resources when both key and value match.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -357,7 +401,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -434,13 +478,16 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				modifyPlanFuncs = append(modifyPlanFuncs, setTagsAll)
 				interceptors = append(interceptors, newTagsResourceInterceptor(v.Tags))
 			}
+			if v := newTagPropagationInterceptor(ctx, sp, typeName); v != nil {
+				interceptors = append(interceptors, v)
+			}
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 					if c != nil {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -498,7 +545,7 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 					bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 						var diags diag.Diagnostics
 
-						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+						ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name, v.TypeName)
 						if c != nil {
 							ctx = c.RegisterLogger(ctx)
 							ctx = flex.RegisterLogger(ctx)
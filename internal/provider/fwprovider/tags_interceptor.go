@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
@@ -255,6 +256,86 @@ func (r tagsResourceInterceptor) delete(ctx context.Context, opts interceptorOpt
 	return diags
 }
 
+// tagPropagationInterceptor implements propagation of default tags to child resources.
+type tagPropagationInterceptor struct {
+	propagations []*types.ServicePackageTagPropagation
+}
+
+// newTagPropagationInterceptor returns an interceptor for the specified resource type,
+// or nil if the service package doesn't declare any child resources for the resource type.
+func newTagPropagationInterceptor(ctx context.Context, sp conns.ServicePackage, typeName string) resourceInterceptor {
+	propagations := interceptors.TagPropagations(ctx, sp, typeName)
+	if len(propagations) == 0 {
+		return nil
+	}
+
+	return &tagPropagationInterceptor{
+		propagations: propagations,
+	}
+}
+
+func (r tagPropagationInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(r.propagate(ctx, opts.c, response.State)...)
+	}
+
+	return diags
+}
+
+func (r tagPropagationInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
+
+func (r tagPropagationInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		diags.Append(r.propagate(ctx, opts.c, response.State)...)
+	}
+
+	return diags
+}
+
+func (r tagPropagationInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return diags
+}
+
+// propagate tags the child resources of the resource in the specified state with the default tags.
+func (r tagPropagationInterceptor) propagate(ctx context.Context, c *conns.AWSClient, state tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	defaultTagsConfig := c.DefaultTagsConfig(ctx)
+	if !defaultTagsConfig.PropagateToChildResources(inContext.TypeName()) {
+		return diags
+	}
+
+	var id string
+	state.GetAttribute(ctx, path.Root(names.AttrID), &id)
+	if id == "" {
+		return diags
+	}
+
+	// Failure to tag child resources doesn't fail the parent resource's operation.
+	if err := interceptors.PropagateTags(ctx, r.propagations, c, id, defaultTagsConfig.GetTags()); err != nil {
+		diags.AddWarning(fmt.Sprintf("propagating tags to child resources of %s (%s)", inContext.TypeName(), id), err.Error())
+	}
+
+	return diags
+}
+
 type tagsInterceptor struct {
 	interceptors.WithTaggingMethods
 }
@@ -5,6 +5,9 @@ package interceptors
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -89,3 +92,54 @@ func (w WithTaggingMethods) UpdateTags(ctx context.Context, sp conns.ServicePack
 
 	return err
 }
+
+// TagPropagations returns the service package's tag propagations for the specified parent resource type.
+func TagPropagations(ctx context.Context, sp conns.ServicePackage, typeName string) []*types.ServicePackageTagPropagation {
+	v, ok := sp.(conns.ServicePackageWithTagPropagations)
+	if !ok {
+		return nil
+	}
+
+	return slices.DeleteFunc(v.TagPropagations(ctx), func(v *types.ServicePackageTagPropagation) bool {
+		return v.TypeName != typeName
+	})
+}
+
+// PropagateTags tags the child resources of the parent resource with the specified ID using the child service package's generic update tags method.
+// Tags are only added or updated; tags are never removed from child resources.
+func PropagateTags(ctx context.Context, propagations []*types.ServicePackageTagPropagation, c *conns.AWSClient, id string, tags tftags.KeyValueTags) error {
+	var errs []error
+
+	for _, propagation := range propagations {
+		sp := c.ServicePackage(ctx, propagation.ChildServicePackageName)
+		if sp == nil {
+			errs = append(errs, fmt.Errorf("service package (%s) not found", propagation.ChildServicePackageName))
+			continue
+		}
+
+		children, err := propagation.ListChildren(ctx, c, id)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("listing %s child resources: %w", propagation.ChildResourceName, err))
+			continue
+		}
+
+		w := WithTaggingMethods{
+			ServicePackageResourceTags: &types.ServicePackageResourceTags{
+				ResourceType: propagation.ChildResourceType,
+			},
+		}
+		for _, child := range children {
+			tflog.Debug(ctx, "Propagating tags", map[string]any{
+				"ChildResource":   propagation.ChildResourceName,
+				"ChildIdentifier": child,
+			})
+
+			if err := w.UpdateTags(ctx, sp, c, child, nil, tags.IgnoreSystem(sp.ServicePackageName())); err != nil {
+				errs = append(errs, fmt.Errorf("updating tags for %s (%s): %w", propagation.ChildResourceName, child, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -10,6 +10,7 @@ import (
 	"log"
 	"maps"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -69,6 +70,45 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validResourceTypePattern},
+							Description: "Resource type name patterns, e.g. `aws_autoscaling_group`, that `tags` are not defaulted across.",
+						},
+						"include_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validResourceTypePattern},
+							Description: "Resource type name patterns, e.g. `aws_s3_*`, that `tags` are defaulted across. Defaults to all resource types.",
+						},
+						"propagate_to_child_resources": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validResourceTypePattern},
+							Description: "Resource type name patterns, e.g. `aws_instance`, whose resources' AWS-created child resources are also tagged with default tags.",
+						},
+						"resource_type_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration block with additional resource tags to default across resources of matching types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_types": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validResourceTypePattern},
+										Description: "Resource type name patterns, e.g. `aws_s3_bucket*`.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across resources of matching types.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -131,6 +171,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_patterns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_values": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags to ignore across all resources when both key and value match.",
+						},
 					},
 				},
 			},
@@ -319,7 +371,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -395,13 +447,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					interceptor: newTagsResourceInterceptor(v.Tags),
 				})
 			}
+			if v := newTagPropagationInterceptor(ctx, sp, typeName); v != nil {
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Update,
+					interceptor: v,
+				})
+			}
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 					if v, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
@@ -852,17 +911,56 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 		maps.Copy(tags, cfgTags)
 	}
 
+	defaultConfig := &tftags.DefaultConfig{}
 	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+		defaultConfig.Tags = tftags.New(ctx, tags)
+	}
+
+	if v, ok := tfMap["include_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.IncludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["propagate_to_child_resources"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.PropagateToChildResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["resource_type_tags"].([]any); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			resourceTypeTags := tftags.ResourceTypeTags{}
+
+			if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+				resourceTypeTags.ResourceTypes = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["tags"].(map[string]any); ok {
+				resourceTypeTags.Tags = tftags.New(ctx, v)
+			}
+
+			defaultConfig.ResourceTypeTags = append(defaultConfig.ResourceTypeTags, resourceTypeTags)
 		}
 	}
 
-	return nil
+	// Return nil when no tags are defaulted.
+	if defaultConfig.Tags == nil && len(defaultConfig.ResourceTypeTags) == 0 {
+		return nil
+	}
+
+	return defaultConfig
 }
 
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
+	var keyPatterns []*regexp.Regexp
+	var keyValues map[string]any
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -871,6 +969,17 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		if v, ok := tfMap["key_patterns"].(*schema.Set); ok {
+			for _, v := range flex.ExpandStringValueSet(v) {
+				// Patterns are validated in the provider schema.
+				if re, err := regexp.Compile(v); err == nil {
+					keyPatterns = append(keyPatterns, re)
+				}
+			}
+		}
+		if v, ok := tfMap["key_values"].(map[string]any); ok {
+			keyValues = v
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -891,10 +1000,10 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 
 	// To preseve behavior prior to supporting environment variables:
 	//
-	// - Return nil when no keys or prefixes are set
+	// - Return nil when no keys, prefixes, patterns or key/value pairs are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(keyPatterns) == 0 && len(keyValues) == 0 {
 		return nil
 	}
 
@@ -905,6 +1014,12 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	if len(keyPrefixes) > 0 {
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, keyPrefixes)
 	}
+	if len(keyPatterns) > 0 {
+		ignoreConfig.KeyPatterns = keyPatterns
+	}
+	if len(keyValues) > 0 {
+		ignoreConfig.KeyValues = tftags.New(ctx, keyValues)
+	}
 
 	return ignoreConfig
 }
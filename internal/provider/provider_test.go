@@ -424,6 +424,99 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandIgnoreTagsKeyPatternsAndValues(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	results := expandIgnoreTags(ctx, map[string]any{
+		"key_patterns": schema.NewSet(schema.HashString, []any{`^scanner:.+$`}),
+		"key_values": map[string]any{
+			"env": "scratch",
+		},
+	})
+
+	if results == nil {
+		t.Fatal("Expected ignore tags config, got nil")
+	}
+
+	got := tftags.New(ctx, map[string]string{
+		"Name":          "example",
+		"env":           "scratch",
+		"scanner:run01": "abc",
+	}).IgnoreConfig(results).Map()
+	want := map[string]string{
+		"Name": "example",
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected ignored tags diff: %s", diff)
+	}
+}
+
+func TestExpandDefaultTagsResourceTypes(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	results := expandDefaultTags(ctx, map[string]any{
+		"tags": map[string]any{
+			"Owner": "platform",
+		},
+		"exclude_resource_types": schema.NewSet(schema.HashString, []any{"aws_autoscaling_group"}),
+		"resource_type_tags": []any{
+			map[string]any{
+				"resource_types": schema.NewSet(schema.HashString, []any{"aws_s3_bucket*"}),
+				"tags": map[string]any{
+					"DataClassification": "internal",
+				},
+			},
+		},
+	})
+
+	if results == nil {
+		t.Fatal("Expected default tags config, got nil")
+	}
+
+	testcases := map[string]struct {
+		typeName string
+		want     map[string]string
+	}{
+		"excluded": {
+			typeName: "aws_autoscaling_group",
+		},
+		"default": {
+			typeName: "aws_vpc",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		"resource type tags": {
+			typeName: "aws_s3_bucket_policy",
+			want: map[string]string{
+				"DataClassification": "internal",
+				"Owner":              "platform",
+			},
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			got := results.ForResourceType(testcase.typeName).GetTags().Map()
+
+			if len(testcase.want) == 0 && len(got) == 0 {
+				return
+			}
+
+			if diff := cmp.Diff(testcase.want, got); diff != "" {
+				t.Errorf("Unexpected default tags diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -176,6 +176,56 @@ func (r tagsResourceInterceptor) run(ctx context.Context, opts interceptorOption
 	return diags
 }
 
+// tagPropagationInterceptor implements propagation of default tags to child resources.
+type tagPropagationInterceptor struct {
+	propagations []*types.ServicePackageTagPropagation
+}
+
+// newTagPropagationInterceptor returns an interceptor for the specified resource type,
+// or nil if the service package doesn't declare any child resources for the resource type.
+func newTagPropagationInterceptor(ctx context.Context, sp conns.ServicePackage, typeName string) interceptor {
+	propagations := interceptors.TagPropagations(ctx, sp, typeName)
+	if len(propagations) == 0 {
+		return nil
+	}
+
+	return &tagPropagationInterceptor{
+		propagations: propagations,
+	}
+}
+
+func (r tagPropagationInterceptor) run(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	defaultTagsConfig := c.DefaultTagsConfig(ctx)
+	if !defaultTagsConfig.PropagateToChildResources(inContext.TypeName()) {
+		return diags
+	}
+
+	switch d, when, why := opts.d, opts.when, opts.why; when {
+	case After:
+		switch why {
+		case Create, Update:
+			if d.Id() == "" {
+				return diags
+			}
+
+			// Failure to tag child resources doesn't fail the parent resource's operation.
+			if err := interceptors.PropagateTags(ctx, r.propagations, c, d.Id(), defaultTagsConfig.GetTags()); err != nil {
+				return sdkdiag.AppendWarningf(diags, "propagating tags to child resources of %s (%s): %s", inContext.TypeName(), d.Id(), err)
+			}
+		}
+	}
+
+	return diags
+}
+
 // tagsResourceInterceptor implements transparent tagging for data sources.
 type tagsDataSourceInterceptor struct {
 	tagsInterceptor
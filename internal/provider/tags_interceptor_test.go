@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -84,7 +85,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}))
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "aws_test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 		}
@@ -158,3 +159,106 @@ func (d *resourceData) HasChange(key string) bool {
 func (d *resourceData) HasChanges(keys ...string) bool {
 	return false
 }
+
+type mockTagPropagationService struct {
+	mockService
+	children []string
+	err      error
+	updated  []string
+}
+
+var (
+	_ conns.ServicePackageWithTagPropagations = &mockTagPropagationService{}
+)
+
+func (t *mockTagPropagationService) TagPropagations(context.Context) []*types.ServicePackageTagPropagation {
+	return []*types.ServicePackageTagPropagation{
+		{
+			TypeName:                "aws_test",
+			ChildResourceName:       "Child",
+			ChildServicePackageName: "Test",
+			ListChildren: func(context.Context, any, string) ([]string, error) {
+				return t.children, t.err
+			},
+		},
+	}
+}
+
+func (t *mockTagPropagationService) UpdateTags(_ context.Context, _ any, identifier string, _, _ any) error {
+	t.updated = append(t.updated, identifier)
+
+	return nil
+}
+
+func TestTagPropagationInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		propagateToChildResourceTypes []string
+		err                           error
+		expectedUpdated               []string
+		expectedDiags                 int
+	}{
+		"not enabled": {},
+		"enabled": {
+			propagateToChildResourceTypes: []string{"aws_test"},
+			expectedUpdated:               []string{"child-1", "child-2"},
+		},
+		"pattern": {
+			propagateToChildResourceTypes: []string{"aws_*"},
+			expectedUpdated:               []string{"child-1", "child-2"},
+		},
+		"list error": {
+			propagateToChildResourceTypes: []string{"aws_test"},
+			err:                           errors.New("test error"),
+			expectedDiags:                 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sp := &mockTagPropagationService{
+				children: []string{"child-1", "child-2"},
+				err:      testCase.err,
+			}
+
+			conn := &conns.AWSClient{}
+			conn.SetServicePackages(ctx, map[string]conns.ServicePackage{
+				"Test": sp,
+			})
+			conns.SetDefaultTagsConfig(conn, &tftags.DefaultConfig{
+				Tags: tftags.New(ctx, map[string]string{
+					"tag1": "value1",
+				}),
+				PropagateToChildResourceTypes: testCase.propagateToChildResourceTypes,
+			})
+
+			interceptor := newTagPropagationInterceptor(ctx, sp, "aws_test")
+			if interceptor == nil {
+				t.Fatal("expected interceptor, got nil")
+			}
+
+			ctx = conns.NewResourceContext(ctx, "Test", "Test", "aws_test")
+			opts := interceptorOptions{
+				c:    conn,
+				d:    &resourceData{},
+				when: After,
+				why:  Create,
+			}
+			diags := interceptor.run(ctx, opts)
+
+			if got, want := len(diags), testCase.expectedDiags; got != want {
+				t.Errorf("length of diags = %v, want %v", got, want)
+			}
+			if diags.HasError() {
+				t.Errorf("unexpected error diags: %v", diags)
+			}
+			if got, want := sp.updated, testCase.expectedUpdated; !slices.Equal(got, want) {
+				t.Errorf("updated = %v, want %v", got, want)
+			}
+		})
+	}
+}
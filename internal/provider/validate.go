@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -28,6 +29,15 @@ func validAssumeRoleDuration(v any, k string) (ws []string, errors []error) {
 	return
 }
 
+// validResourceTypePattern validates a string is a valid resource type name pattern, as accepted by filepath.Match
+func validResourceTypePattern(v any, k string) (ws []string, errors []error) {
+	if _, err := filepath.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid resource type pattern: %w", k, err))
+	}
+
+	return
+}
+
 var validAssumeRoleSessionName = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
@@ -73,3 +73,39 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidResourceTypePattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         any
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val:         "aws_s3_[",
+			expectedErr: regexache.MustCompile(`is not a valid resource type pattern`),
+		},
+		{
+			val: "aws_autoscaling_group",
+		},
+		{
+			val: "aws_s3_bucket*",
+		},
+	}
+
+	for i, tc := range testCases {
+		_, errs := validResourceTypePattern(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if len(errs) == 0 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
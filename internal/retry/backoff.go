@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff computes the delay before a retry.
+type Backoff interface {
+	// Delay returns the delay before the specified retry. The first retry is 1.
+	Delay(retry int) time.Duration
+}
+
+// ExponentialJitterBackoff is exponential backoff with "full jitter".
+// The delay before the nth retry is a random duration between 0 and MinDelay * Multiplier**(n-1), capped at MaxDelay.
+// See https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/.
+type ExponentialJitterBackoff struct {
+	MinDelay   time.Duration
+	MaxDelay   time.Duration // If zero, the delay is not capped.
+	Multiplier float64       // If specified, must be at least 1. Defaults to 2.
+}
+
+func (b ExponentialJitterBackoff) Delay(retry int) time.Duration {
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	d := float64(b.MinDelay) * math.Pow(multiplier, float64(max(retry-1, 0)))
+	if b.MaxDelay > 0 {
+		d = min(d, float64(b.MaxDelay))
+	}
+
+	return time.Duration(rand.Float64() * d)
+}
+
+// ConstantBackoff waits the same duration before every retry.
+type ConstantBackoff time.Duration
+
+func (b ConstantBackoff) Delay(int) time.Duration {
+	return time.Duration(b)
+}
+
+// DefaultBackoff is the Backoff used by retry loops that don't specify one.
+var DefaultBackoff Backoff = ExponentialJitterBackoff{
+	MinDelay:   500 * time.Millisecond,
+	MaxDelay:   10 * time.Second,
+	Multiplier: 2,
+}
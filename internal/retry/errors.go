@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// errRunTimeout is the cause of a retry loop's own timeout, distinguishing it from the caller's context being done.
+var errRunTimeout = errors.New("retry timeout")
+
+// TimeoutError is returned when an operation is still being retried when its timeout elapses.
+type TimeoutError struct {
+	LastError error // The error returned by the last attempt, if any.
+	Timeout   time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	if e.LastError != nil {
+		return fmt.Sprintf("timeout while retrying (%s), last error: %s", e.Timeout, e.LastError)
+	}
+
+	return fmt.Sprintf("timeout while retrying (%s)", e.Timeout)
+}
+
+func (e *TimeoutError) Unwrap() []error {
+	if e.LastError != nil {
+		return []error{context.DeadlineExceeded, e.LastError}
+	}
+
+	return []error{context.DeadlineExceeded}
+}
+
+// TimedOut returns true if the error is a TimeoutError.
+func TimedOut(err error) bool {
+	_, ok := errs.As[*TimeoutError](err)
+	return ok
+}
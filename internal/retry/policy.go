@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"strings"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// ErrorPredicate reports whether an error should be retried.
+// It is never called with a nil error.
+type ErrorPredicate func(error) bool
+
+// Or returns an ErrorPredicate that matches if any of the specified predicates match.
+func Or(predicates ...ErrorPredicate) ErrorPredicate {
+	return func(err error) bool {
+		for _, predicate := range predicates {
+			if predicate(err) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// And returns an ErrorPredicate that matches if all of the specified predicates match.
+func And(predicates ...ErrorPredicate) ErrorPredicate {
+	return func(err error) bool {
+		for _, predicate := range predicates {
+			if !predicate(err) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Not returns an ErrorPredicate that matches if the specified predicate doesn't match.
+func Not(predicate ErrorPredicate) ErrorPredicate {
+	return func(err error) bool {
+		return !predicate(err)
+	}
+}
+
+// ErrorCodeEquals matches AWS errors with any of the specified error codes.
+func ErrorCodeEquals(codes ...string) ErrorPredicate {
+	return func(err error) bool {
+		return tfawserr.ErrCodeEquals(err, codes...)
+	}
+}
+
+// ErrorCodeContains matches AWS errors whose error code contains the specified string.
+func ErrorCodeContains(code string) ErrorPredicate {
+	return func(err error) bool {
+		return tfawserr.ErrCodeContains(err, code)
+	}
+}
+
+// ErrorMessageContains matches AWS errors with the specified error code whose message contains the specified string.
+func ErrorMessageContains(code, message string) ErrorPredicate {
+	return func(err error) bool {
+		return tfawserr.ErrMessageContains(err, code, message)
+	}
+}
+
+// IsA matches errors of type T anywhere in the error tree.
+func IsA[T error]() ErrorPredicate {
+	return func(err error) bool {
+		return errs.IsA[T](err)
+	}
+}
+
+// IsAErrorMessageContains matches errors of type T whose message contains the specified string.
+func IsAErrorMessageContains[T errs.ErrorWithErrorMessage](needle string) ErrorPredicate {
+	return func(err error) bool {
+		return errs.IsAErrorMessageContains[T](err, needle)
+	}
+}
+
+// MessageContains matches errors whose string representation contains the specified string.
+func MessageContains(needle string) ErrorPredicate {
+	return func(err error) bool {
+		return strings.Contains(err.Error(), needle)
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Op[T any] interface {
@@ -36,6 +36,8 @@ type operation[T any] struct {
 	op                Op[T]
 	predicate         Predicate[T]
 	transformRunError func(error) error
+	backoff           Backoff
+	maxAttempts       int
 }
 
 // Operation returns a new wrapper on top of the specified function.
@@ -48,23 +50,56 @@ func Operation[T any](op OpFunc[T]) operation[T] {
 		}),
 		// The default error transformer does nothing.
 		transformRunError: func(err error) error { return err },
+		backoff:           DefaultBackoff,
 	}
 }
 
 func (o operation[T]) withPredicate(predicate Predicate[T]) operation[T] {
-	return operation[T]{op: o.op, predicate: predicate, transformRunError: o.transformRunError}
+	o.predicate = predicate
+	return o
 }
 
 func (o operation[T]) withTransformRunError(f func(error) error) operation[T] {
-	return operation[T]{op: o.op, predicate: o.predicate, transformRunError: f}
+	o.transformRunError = f
+	return o
 }
 
 func (o operation[T]) If(predicate PredicateFunc[T]) operation[T] {
 	return o.withPredicate(predicate)
 }
 
-// UntilFoundN retries an operation if it returns a retry.NotFoundError.
-func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
+// When retries an operation if it returns an error matching any of the specified predicates.
+func (o operation[T]) When(predicates ...ErrorPredicate) operation[T] {
+	retryable := Or(predicates...)
+
+	predicate := func(_ T, err error) (bool, error) {
+		if err != nil && retryable(err) {
+			return true, err
+		}
+
+		return false, err
+	}
+
+	return o.If(predicate)
+}
+
+// WithBackoff sets the backoff between retries.
+func (o operation[T]) WithBackoff(backoff Backoff) operation[T] {
+	o.backoff = backoff
+	return o
+}
+
+// WithMaxAttempts limits the number of times the operation is invoked.
+// Zero means no limit other than the timeout.
+func (o operation[T]) WithMaxAttempts(maxAttempts int) operation[T] {
+	o.maxAttempts = maxAttempts
+	return o
+}
+
+// UntilFoundN retries an operation while it returns an error matching notFound,
+// until it succeeds continuousTargetOccurence times in a row.
+// Finders' "resource not found" errors are matched by tfresource.NotFound.
+func (o operation[T]) UntilFoundN(continuousTargetOccurence int, notFound ErrorPredicate) operation[T] {
 	if continuousTargetOccurence < 1 {
 		continuousTargetOccurence = 1
 	}
@@ -82,7 +117,7 @@ func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) { // nosemgrep:ci.semgrep.errors.notfound-without-err-checks
+		if notFound(err) {
 			targetOccurence = 0
 
 			return true, err
@@ -94,13 +129,14 @@ func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 	return o.If(predicate)
 }
 
-func (o operation[T]) UntilNotFound() operation[T] {
+// UntilNotFound retries an operation until it returns an error matching notFound.
+func (o operation[T]) UntilNotFound(notFound ErrorPredicate) operation[T] {
 	predicate := func(_ T, err error) (bool, error) {
 		if err == nil {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) { // nosemgrep:ci.semgrep.errors.notfound-without-err-checks
+		if notFound(err) {
 			return false, nil
 		}
 
@@ -119,18 +155,72 @@ func (o operation[T]) UntilNotFound() operation[T] {
 }
 
 // Run retries an operation until the timeout elapses or predicate indicates otherwise.
+// The timeout is applied in addition to any deadline on ctx.
+// If the timeout elapses while the operation is still being retried a *TimeoutError is returned.
+// If ctx is done first, the last error is returned, or ctx.Err() if the last attempt didn't fail.
 func (o operation[T]) Run(ctx context.Context, timeout time.Duration) (T, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	runCtx, cancel := context.WithTimeoutCause(ctx, timeout, errRunTimeout)
 	defer cancel()
 
-	for r := Begin(); r.Continue(ctx); {
-		t, err := o.op.Invoke(ctx)
+	var lastErr error
 
-		if retry, err := o.predicate.Invoke(t, err); !retry {
+	for attempt := 1; ; attempt++ {
+		t, err := o.op.Invoke(runCtx)
+
+		retry, err := o.predicate.Invoke(t, err)
+		if !retry {
 			return t, err
 		}
+
+		lastErr = err
+
+		if o.maxAttempts > 0 && attempt >= o.maxAttempts {
+			tflog.Debug(runCtx, "Retry attempts exhausted", logFields(attempt, err))
+
+			return t, err
+		}
+
+		delay := o.backoff.Delay(attempt)
+
+		// Don't sleep beyond the deadline.
+		if deadline, ok := runCtx.Deadline(); ok {
+			delay = min(delay, time.Until(deadline))
+		}
+
+		fields := logFields(attempt, err)
+		fields["delay"] = delay.String()
+		tflog.Debug(runCtx, "Retrying operation", fields)
+
+		if sleep(runCtx, delay); runCtx.Err() != nil {
+			break
+		}
 	}
 
 	var t T
-	return t, o.transformRunError(ctx.Err())
+	var err error
+
+	if errors.Is(context.Cause(runCtx), errRunTimeout) {
+		err = &TimeoutError{
+			LastError: lastErr,
+			Timeout:   timeout,
+		}
+	} else if lastErr != nil {
+		err = lastErr
+	} else {
+		err = ctx.Err()
+	}
+
+	return t, o.transformRunError(err)
+}
+
+func logFields(attempt int, err error) map[string]any {
+	fields := map[string]any{
+		"attempt": attempt,
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	return fields
 }
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestErrorPredicates(t *testing.T) {
+	t.Parallel()
+
+	apiErr := &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+	notFoundErr := &types.NoSuchBucket{Message: aws.String("The specified bucket does not exist")}
+
+	testCases := map[string]struct {
+		predicate ErrorPredicate
+		err       error
+		expected  bool
+	}{
+		"ErrorCodeEquals match": {
+			predicate: ErrorCodeEquals("InvalidParameter", "ThrottlingException"),
+			err:       apiErr,
+			expected:  true,
+		},
+		"ErrorCodeEquals no match": {
+			predicate: ErrorCodeEquals("InvalidParameter"),
+			err:       apiErr,
+		},
+		"ErrorCodeContains match": {
+			predicate: ErrorCodeContains("Throttling"),
+			err:       apiErr,
+			expected:  true,
+		},
+		"ErrorMessageContains match": {
+			predicate: ErrorMessageContains("ThrottlingException", "exceeded"),
+			err:       apiErr,
+			expected:  true,
+		},
+		"ErrorMessageContains wrong code": {
+			predicate: ErrorMessageContains("InvalidParameter", "exceeded"),
+			err:       apiErr,
+		},
+		"IsA match": {
+			predicate: IsA[*types.NoSuchBucket](),
+			err:       fmt.Errorf("wrapped: %w", notFoundErr),
+			expected:  true,
+		},
+		"IsA no match": {
+			predicate: IsA[*types.NoSuchKey](),
+			err:       notFoundErr,
+		},
+		"IsAErrorMessageContains match": {
+			predicate: IsAErrorMessageContains[*types.NoSuchBucket]("does not exist"),
+			err:       notFoundErr,
+			expected:  true,
+		},
+		"MessageContains match": {
+			predicate: MessageContains("Rate exceeded"),
+			err:       apiErr,
+			expected:  true,
+		},
+		"Or match": {
+			predicate: Or(IsA[*types.NoSuchKey](), ErrorCodeEquals("ThrottlingException")),
+			err:       apiErr,
+			expected:  true,
+		},
+		"And no match": {
+			predicate: And(ErrorCodeContains("Throttling"), MessageContains("slow down")),
+			err:       apiErr,
+		},
+		"Not match": {
+			predicate: Not(IsA[*types.NoSuchBucket]()),
+			err:       apiErr,
+			expected:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.predicate(testCase.err), testCase.expected; got != want {
+				t.Errorf("predicate = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestExponentialJitterBackoff(t *testing.T) {
+	t.Parallel()
+
+	backoff := ExponentialJitterBackoff{
+		MinDelay:   100 * time.Millisecond,
+		MaxDelay:   time.Second,
+		Multiplier: 2,
+	}
+
+	for retry, ceiling := range map[int]time.Duration{
+		1:  100 * time.Millisecond,
+		2:  200 * time.Millisecond,
+		4:  800 * time.Millisecond,
+		10: time.Second,
+	} {
+		for range 100 {
+			if d := backoff.Delay(retry); d < 0 || d > ceiling {
+				t.Fatalf("retry %d: delay %s not in [0, %s]", retry, d, ceiling)
+			}
+		}
+	}
+}
+
+func TestOperationRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errRetryable := errors.New("retryable")
+	errOther := errors.New("other")
+
+	t.Run("succeeds after retries", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts int
+		got, err := Operation(func(context.Context) (int, error) {
+			if attempts++; attempts < 3 {
+				return 0, errRetryable
+			}
+			return attempts, nil
+		}).When(MessageContains("retryable")).WithBackoff(ConstantBackoff(time.Millisecond)).Run(ctx, 5*time.Second)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != 3 {
+			t.Errorf("attempts = %d, want 3", got)
+		}
+	})
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts int
+		_, err := Operation(func(context.Context) (int, error) {
+			attempts++
+			return 0, errOther
+		}).When(MessageContains("retryable")).WithBackoff(ConstantBackoff(time.Millisecond)).Run(ctx, 5*time.Second)
+
+		if !errors.Is(err, errOther) {
+			t.Fatalf("error = %v, want %v", err, errOther)
+		}
+		if attempts != 1 {
+			t.Errorf("attempts = %d, want 1", attempts)
+		}
+	})
+
+	t.Run("max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		var attempts int
+		_, err := Operation(func(context.Context) (int, error) {
+			attempts++
+			return 0, errRetryable
+		}).When(MessageContains("retryable")).WithBackoff(ConstantBackoff(time.Millisecond)).WithMaxAttempts(4).Run(ctx, 5*time.Second)
+
+		if !errors.Is(err, errRetryable) {
+			t.Fatalf("error = %v, want %v", err, errRetryable)
+		}
+		if attempts != 4 {
+			t.Errorf("attempts = %d, want 4", attempts)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Operation(func(context.Context) (int, error) {
+			return 0, errRetryable
+		}).When(MessageContains("retryable")).WithBackoff(ConstantBackoff(10*time.Millisecond)).Run(ctx, 50*time.Millisecond)
+
+		if !TimedOut(err) {
+			t.Fatalf("error = %v, want TimeoutError", err)
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("error = %v, want context.DeadlineExceeded", err)
+		}
+		if !errors.Is(err, errRetryable) {
+			t.Errorf("error = %v, want %v", err, errRetryable)
+		}
+	})
+
+	t.Run("delay capped at deadline", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now()
+		_, err := Operation(func(context.Context) (int, error) {
+			return 0, errRetryable
+		}).When(MessageContains("retryable")).WithBackoff(ConstantBackoff(time.Hour)).Run(ctx, 50*time.Millisecond)
+
+		if !TimedOut(err) {
+			t.Fatalf("error = %v, want TimeoutError", err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("elapsed = %s, want close to timeout", elapsed)
+		}
+	})
+	t.Run("context done", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := Operation(func(context.Context) (int, error) {
+			return 0, errRetryable
+		}).When(MessageContains("retryable")).WithBackoff(ConstantBackoff(10*time.Millisecond)).Run(ctx, time.Hour)
+
+		if TimedOut(err) {
+			t.Fatalf("error = %v, want last error", err)
+		}
+		if !errors.Is(err, errRetryable) {
+			t.Errorf("error = %v, want %v", err, errRetryable)
+		}
+	})
+
+	t.Run("until not found", func(t *testing.T) {
+		t.Parallel()
+
+		errNotFound := errors.New("not found")
+
+		var attempts int
+		_, err := Operation(func(context.Context) (int, error) {
+			if attempts++; attempts < 3 {
+				return attempts, nil
+			}
+			return 0, errNotFound
+		}).UntilNotFound(func(err error) bool {
+			return errors.Is(err, errNotFound)
+		}).WithBackoff(ConstantBackoff(time.Millisecond)).Run(ctx, 5*time.Second)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if attempts != 3 {
+			t.Errorf("attempts = %d, want 3", attempts)
+		}
+	})
+}
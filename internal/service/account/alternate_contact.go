@@ -116,7 +116,7 @@ func resourceAlternateContactCreate(ctx context.Context, d *schema.ResourceData,
 	)
 	_, err = retry.Operation(func(ctx context.Context) (*types.AlternateContact, error) {
 		return findAlternateContactByTwoPartKey(ctx, conn, accountID, contactType)
-	}).UntilFoundN(inARow).Run(ctx, d.Timeout(schema.TimeoutCreate))
+	}).UntilFoundN(inARow, tfresource.NotFound).Run(ctx, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Account Alternate Contact (%s) create: %s", d.Id(), err)
@@ -242,7 +242,7 @@ func resourceAlternateContactDelete(ctx context.Context, d *schema.ResourceData,
 
 	_, err = retry.Operation(func(ctx context.Context) (*types.AlternateContact, error) {
 		return findAlternateContactByTwoPartKey(ctx, conn, accountID, contactType)
-	}).UntilNotFound().Run(ctx, d.Timeout(schema.TimeoutDelete))
+	}).UntilNotFound(tfresource.NotFound).Run(ctx, d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Account Alternate Contact (%s) delete: %s", d.Id(), err)
@@ -9,6 +9,7 @@ var (
 	FindDataCatalogByName             = findDataCatalogByName
 	FindDatabaseByName                = findDatabaseByName
 	FindNamedQueryByID                = findNamedQueryByID
+	FindNotebookByID                  = findNotebookByID
 	FindPreparedStatementByTwoPartKey = findPreparedStatementByTwoPartKey
 	FindWorkGroupByName               = findWorkGroupByName
 	QueryExecutionResult              = queryExecutionResult
@@ -17,6 +18,7 @@ var (
 	ResourceDataCatalog         = resourceDataCatalog
 	ResourceDatabase            = resourceDatabase
 	ResourceNamedQuery          = resourceNamedQuery
+	ResourceNotebook            = newResourceNotebook
 	ResourcePreparedStatement   = resourcePreparedStatement
 	ResourceWorkGroup           = resourceWorkGroup
 )
@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_athena_notebook", name="Notebook")
+func newResourceNotebook(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceNotebook{}, nil
+}
+
+const (
+	ResNameNotebook = "Notebook"
+)
+
+type resourceNotebook struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourceNotebook) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_modified_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"payload": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 10485760),
+				},
+			},
+			"workgroup": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceNotebook) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().AthenaClient(ctx)
+
+	var plan resourceNotebookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := athena.ImportNotebookInput{
+		Name:      plan.Name.ValueStringPointer(),
+		Payload:   plan.Payload.ValueStringPointer(),
+		Type:      awstypes.NotebookTypeIpynb,
+		WorkGroup: plan.WorkGroup.ValueStringPointer(),
+	}
+
+	out, err := conn.ImportNotebook(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Athena, create.ErrActionCreating, ResNameNotebook, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.NotebookId)
+
+	notebook, err := findNotebookMetadataByID(ctx, conn, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Athena, create.ErrActionReading, ResNameNotebook, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.CreationTime = timetypes.NewRFC3339TimePointerValue(notebook.CreationTime)
+	plan.LastModifiedTime = timetypes.NewRFC3339TimePointerValue(notebook.LastModifiedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceNotebook) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().AthenaClient(ctx)
+
+	var state resourceNotebookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findNotebookByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Athena, create.ErrActionReading, ResNameNotebook, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	notebook := out.NotebookMetadata
+	state.CreationTime = timetypes.NewRFC3339TimePointerValue(notebook.CreationTime)
+	state.LastModifiedTime = timetypes.NewRFC3339TimePointerValue(notebook.LastModifiedTime)
+	state.Name = flex.StringToFramework(ctx, notebook.Name)
+	state.WorkGroup = flex.StringToFramework(ctx, notebook.WorkGroup)
+
+	// Athena adds its own metadata to exported notebooks, so the payload is only read on import.
+	if state.Payload.IsNull() {
+		state.Payload = jsontypes.NewNormalizedPointerValue(out.Payload)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceNotebook) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().AthenaClient(ctx)
+
+	var plan, state resourceNotebookModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		input := athena.UpdateNotebookMetadataInput{
+			Name:       plan.Name.ValueStringPointer(),
+			NotebookId: plan.ID.ValueStringPointer(),
+		}
+
+		if _, err := conn.UpdateNotebookMetadata(ctx, &input); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Athena, create.ErrActionUpdating, ResNameNotebook, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.Payload.Equal(state.Payload) {
+		input := athena.UpdateNotebookInput{
+			NotebookId: plan.ID.ValueStringPointer(),
+			Payload:    plan.Payload.ValueStringPointer(),
+			Type:       awstypes.NotebookTypeIpynb,
+		}
+
+		if _, err := conn.UpdateNotebook(ctx, &input); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Athena, create.ErrActionUpdating, ResNameNotebook, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	notebook, err := findNotebookMetadataByID(ctx, conn, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Athena, create.ErrActionReading, ResNameNotebook, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.LastModifiedTime = timetypes.NewRFC3339TimePointerValue(notebook.LastModifiedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceNotebook) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().AthenaClient(ctx)
+
+	var state resourceNotebookModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := athena.DeleteNotebookInput{
+		NotebookId: state.ID.ValueStringPointer(),
+	}
+
+	if _, err := conn.DeleteNotebook(ctx, &input); err != nil {
+		if isNotebookNotFoundError(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Athena, create.ErrActionDeleting, ResNameNotebook, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func isNotebookNotFoundError(err error) bool {
+	return errs.IsA[*awstypes.ResourceNotFoundException](err) ||
+		errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "not found")
+}
+
+func findNotebookByID(ctx context.Context, conn *athena.Client, id string) (*athena.ExportNotebookOutput, error) {
+	input := athena.ExportNotebookInput{
+		NotebookId: aws.String(id),
+	}
+
+	out, err := conn.ExportNotebook(ctx, &input)
+	if err != nil {
+		if isNotebookNotFoundError(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.NotebookMetadata == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return out, nil
+}
+
+func findNotebookMetadataByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.NotebookMetadata, error) {
+	input := athena.GetNotebookMetadataInput{
+		NotebookId: aws.String(id),
+	}
+
+	out, err := conn.GetNotebookMetadata(ctx, &input)
+	if err != nil {
+		if isNotebookNotFoundError(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: &input,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.NotebookMetadata == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return out.NotebookMetadata, nil
+}
+
+type resourceNotebookModel struct {
+	CreationTime     timetypes.RFC3339    `tfsdk:"creation_time"`
+	ID               types.String         `tfsdk:"id"`
+	LastModifiedTime timetypes.RFC3339    `tfsdk:"last_modified_time"`
+	Name             types.String         `tfsdk:"name"`
+	Payload          jsontypes.Normalized `tfsdk:"payload"`
+	WorkGroup        types.String         `tfsdk:"workgroup"`
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAthenaNotebook_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_notebook.test"
+	workGroupResourceName := "aws_athena_workgroup.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotebookDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotebookConfig_basic(rName, rName, "print('hello')"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotebookExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "payload"),
+					resource.TestCheckResourceAttrPair(resourceName, "workgroup", workGroupResourceName, names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Athena adds its own metadata to the exported notebook.
+				ImportStateVerifyIgnore: []string{"payload"},
+			},
+		},
+	})
+}
+
+func TestAccAthenaNotebook_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_notebook.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotebookDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotebookConfig_basic(rName, rName, "print('hello')"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotebookExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfathena.ResourceNotebook, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAthenaNotebook_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_notebook.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotebookDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotebookConfig_basic(rName, rName, "print('hello')"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotebookExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				Config: testAccNotebookConfig_basic(rName, rNameUpdated, "print('goodbye')"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotebookExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+					resource.TestMatchResourceAttr(resourceName, "payload", regexache.MustCompile(`goodbye`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNotebookDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_athena_notebook" {
+				continue
+			}
+
+			_, err := tfathena.FindNotebookByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Athena Notebook %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNotebookExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaClient(ctx)
+
+		_, err := tfathena.FindNotebookByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccNotebookConfig_basic(rName, notebookName, source string) string {
+	return acctest.ConfigCompose(testAccWorkGroupConfig_sparkBase(rName), fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+
+  configuration {
+    execution_role = aws_iam_role.test.arn
+
+    engine_version {
+      selected_engine_version = "PySpark engine version 3"
+    }
+
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.id}/notebooks/"
+    }
+  }
+}
+
+resource "aws_athena_notebook" "test" {
+  name      = %[2]q
+  workgroup = aws_athena_workgroup.test.name
+
+  payload = jsonencode({
+    cells = [{
+      cell_type       = "code"
+      execution_count = null
+      metadata        = {}
+      outputs         = []
+      source          = [%[3]q]
+    }]
+    metadata       = {}
+    nbformat       = 4
+    nbformat_minor = 5
+  })
+}
+`, rName, notebookName, source))
+}
@@ -28,6 +28,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newResourceNotebook,
+			TypeName: "aws_athena_notebook",
+			Name:     "Notebook",
+		},
 	}
 }
 
@@ -52,6 +52,11 @@ func resourceWorkGroup() *schema.Resource {
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
 						"bytes_scanned_cutoff_per_query": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -60,6 +65,24 @@ func resourceWorkGroup() *schema.Resource {
 								validation.IntInSlice([]int{0}),
 							),
 						},
+						"customer_content_encryption_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"enable_minimum_encryption_configuration": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 						"enforce_workgroup_configuration": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -267,6 +290,10 @@ func resourceWorkGroupUpdate(ctx context.Context, d *schema.ResourceData, meta a
 
 		if d.HasChange(names.AttrConfiguration) {
 			input.ConfigurationUpdates = expandWorkGroupConfigurationUpdates(d.Get(names.AttrConfiguration).([]any))
+
+			if o, n := d.GetChange("configuration.0.customer_content_encryption_configuration"); len(o.([]any)) > 0 && len(n.([]any)) == 0 && input.ConfigurationUpdates != nil {
+				input.ConfigurationUpdates.RemoveCustomerContentEncryptionConfiguration = aws.Bool(true)
+			}
 		}
 
 		if d.HasChange(names.AttrDescription) {
@@ -343,10 +370,22 @@ func expandWorkGroupConfiguration(l []any) *types.WorkGroupConfiguration {
 
 	configuration := &types.WorkGroupConfiguration{}
 
+	if v, ok := m["additional_configuration"].(string); ok && v != "" {
+		configuration.AdditionalConfiguration = aws.String(v)
+	}
+
 	if v, ok := m["bytes_scanned_cutoff_per_query"].(int); ok && v > 0 {
 		configuration.BytesScannedCutoffPerQuery = aws.Int64(int64(v))
 	}
 
+	if v, ok := m["customer_content_encryption_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		configuration.CustomerContentEncryptionConfiguration = expandWorkGroupCustomerContentEncryptionConfiguration(v)
+	}
+
+	if v, ok := m["enable_minimum_encryption_configuration"].(bool); ok {
+		configuration.EnableMinimumEncryptionConfiguration = aws.Bool(v)
+	}
+
 	if v, ok := m["enforce_workgroup_configuration"].(bool); ok {
 		configuration.EnforceWorkGroupConfiguration = aws.Bool(v)
 	}
@@ -390,6 +429,22 @@ func expandWorkGroupEngineVersion(l []any) *types.EngineVersion {
 	return engineVersion
 }
 
+func expandWorkGroupCustomerContentEncryptionConfiguration(l []any) *types.CustomerContentEncryptionConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]any)
+
+	customerContentEncryptionConfiguration := &types.CustomerContentEncryptionConfiguration{}
+
+	if v, ok := m["kms_key"].(string); ok && v != "" {
+		customerContentEncryptionConfiguration.KmsKey = aws.String(v)
+	}
+
+	return customerContentEncryptionConfiguration
+}
+
 func expandWorkGroupConfigurationUpdates(l []any) *types.WorkGroupConfigurationUpdates {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -399,12 +454,24 @@ func expandWorkGroupConfigurationUpdates(l []any) *types.WorkGroupConfigurationU
 
 	configurationUpdates := &types.WorkGroupConfigurationUpdates{}
 
+	if v, ok := m["additional_configuration"].(string); ok && v != "" {
+		configurationUpdates.AdditionalConfiguration = aws.String(v)
+	}
+
 	if v, ok := m["bytes_scanned_cutoff_per_query"].(int); ok && v > 0 {
 		configurationUpdates.BytesScannedCutoffPerQuery = aws.Int64(int64(v))
 	} else {
 		configurationUpdates.RemoveBytesScannedCutoffPerQuery = aws.Bool(true)
 	}
 
+	if v, ok := m["customer_content_encryption_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		configurationUpdates.CustomerContentEncryptionConfiguration = expandWorkGroupCustomerContentEncryptionConfiguration(v)
+	}
+
+	if v, ok := m["enable_minimum_encryption_configuration"].(bool); ok {
+		configurationUpdates.EnableMinimumEncryptionConfiguration = aws.Bool(v)
+	}
+
 	if v, ok := m["enforce_workgroup_configuration"].(bool); ok {
 		configurationUpdates.EnforceWorkGroupConfiguration = aws.Bool(v)
 	}
@@ -522,13 +589,28 @@ func flattenWorkGroupConfiguration(configuration *types.WorkGroupConfiguration)
 	}
 
 	m := map[string]any{
-		"bytes_scanned_cutoff_per_query":     aws.ToInt64(configuration.BytesScannedCutoffPerQuery),
-		"enforce_workgroup_configuration":    aws.ToBool(configuration.EnforceWorkGroupConfiguration),
-		names.AttrEngineVersion:              flattenWorkGroupEngineVersion(configuration.EngineVersion),
-		"execution_role":                     aws.ToString(configuration.ExecutionRole),
-		"publish_cloudwatch_metrics_enabled": aws.ToBool(configuration.PublishCloudWatchMetricsEnabled),
-		"result_configuration":               flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
-		"requester_pays_enabled":             aws.ToBool(configuration.RequesterPaysEnabled),
+		"additional_configuration":                  aws.ToString(configuration.AdditionalConfiguration),
+		"bytes_scanned_cutoff_per_query":            aws.ToInt64(configuration.BytesScannedCutoffPerQuery),
+		"customer_content_encryption_configuration": flattenWorkGroupCustomerContentEncryptionConfiguration(configuration.CustomerContentEncryptionConfiguration),
+		"enable_minimum_encryption_configuration":   aws.ToBool(configuration.EnableMinimumEncryptionConfiguration),
+		"enforce_workgroup_configuration":           aws.ToBool(configuration.EnforceWorkGroupConfiguration),
+		names.AttrEngineVersion:                     flattenWorkGroupEngineVersion(configuration.EngineVersion),
+		"execution_role":                            aws.ToString(configuration.ExecutionRole),
+		"publish_cloudwatch_metrics_enabled":        aws.ToBool(configuration.PublishCloudWatchMetricsEnabled),
+		"result_configuration":                      flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
+		"requester_pays_enabled":                    aws.ToBool(configuration.RequesterPaysEnabled),
+	}
+
+	return []any{m}
+}
+
+func flattenWorkGroupCustomerContentEncryptionConfiguration(customerContentEncryptionConfiguration *types.CustomerContentEncryptionConfiguration) []any {
+	if customerContentEncryptionConfiguration == nil {
+		return []any{}
+	}
+
+	m := map[string]any{
+		"kms_key": aws.ToString(customerContentEncryptionConfiguration.KmsKey),
 	}
 
 	return []any{m}
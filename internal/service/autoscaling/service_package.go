@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func (p *servicePackage) TagPropagations(context.Context) []*types.ServicePackageTagPropagation {
+	return []*types.ServicePackageTagPropagation{
+		{
+			TypeName:                "aws_autoscaling_group",
+			ChildResourceName:       "EC2 Instance",
+			ChildServicePackageName: names.EC2,
+			ListChildren:            listGroupInstanceIDs,
+		},
+	}
+}
+
+// listGroupInstanceIDs returns the IDs of the group's current instances.
+// Instances launched later are tagged via the group's `tag` blocks' `propagate_at_launch`.
+func listGroupInstanceIDs(ctx context.Context, meta any, id string) ([]string, error) {
+	conn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
+
+	group, err := findGroupByName(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(group.Instances, func(v awstypes.Instance) string {
+		return aws.ToString(v.InstanceId)
+	}), nil
+}
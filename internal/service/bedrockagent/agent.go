@@ -628,6 +628,27 @@ func waitAgentVersioned(ctx context.Context, conn *bedrockagent.Client, id strin
 	return nil, err
 }
 
+// waitAgentStable waits for any in-flight create, update, prepare or versioning operation on the agent to finish.
+// An agent that settles in NOT_PREPARED is an error as its DRAFT version can't be used.
+func waitAgentStable(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusCreating, awstypes.AgentStatusUpdating, awstypes.AgentStatusPreparing, awstypes.AgentStatusVersioning),
+		Target:  enum.Slice(awstypes.AgentStatusPrepared),
+		Refresh: statusAgent(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Agent); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitAgentDeleted(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*awstypes.Agent, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.AgentStatusDeleting, awstypes.AgentStatusCreating),
@@ -123,6 +123,13 @@ func (r *agentAliasResource) Create(ctx context.Context, request resource.Create
 	input.ClientToken = aws.String(id.UniqueId())
 	input.Tags = getTagsIn(ctx)
 
+	// Never create an alias against an unprepared DRAFT.
+	if _, err := waitAgentStable(ctx, conn, data.AgentID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) to be prepared", data.AgentID.ValueString()), err.Error())
+
+		return
+	}
+
 	output, err := conn.CreateAgentAlias(ctx, input)
 
 	if err != nil {
@@ -223,6 +230,12 @@ func (r *agentAliasResource) Update(ctx context.Context, request resource.Update
 			return
 		}
 
+		if _, err := waitAgentStable(ctx, conn, new.AgentID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent (%s) to be prepared", new.AgentID.ValueString()), err.Error())
+
+			return
+		}
+
 		_, err := conn.UpdateAgentAlias(ctx, input)
 
 		if err != nil {
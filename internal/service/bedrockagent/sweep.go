@@ -18,7 +18,9 @@ import (
 func RegisterSweepers() {
 	awsv2.Register("aws_bedrockagent_agent", sweepAgents)
 	awsv2.Register("aws_bedrockagent_data_source", sweepDataSources)
+	awsv2.Register("aws_bedrockagent_flow", sweepFlows)
 	awsv2.Register("aws_bedrockagent_knowledge_base", sweepKnowledgeBases, "aws_bedrockagent_agent", "aws_bedrockagent_data_source")
+	awsv2.Register("aws_bedrockagent_prompt", sweepPrompts, "aws_bedrockagent_flow")
 }
 
 func sweepAgents(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -80,6 +82,28 @@ func sweepDataSources(ctx context.Context, client *conns.AWSClient) ([]sweep.Swe
 	return sweepResources, nil
 }
 
+func sweepFlows(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	input := &bedrockagent.ListFlowsInput{}
+	conn := client.BedrockAgentClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := bedrockagent.NewListFlowsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.FlowSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newFlowResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
 func sweepKnowledgeBases(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	input := &bedrockagent.ListKnowledgeBasesInput{}
 	conn := client.BedrockAgentClient(ctx)
@@ -101,3 +125,25 @@ func sweepKnowledgeBases(ctx context.Context, client *conns.AWSClient) ([]sweep.
 
 	return sweepResources, nil
 }
+
+func sweepPrompts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	input := &bedrockagent.ListPromptsInput{}
+	conn := client.BedrockAgentClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := bedrockagent.NewListPromptsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PromptSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newPromptResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id))))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -6,6 +6,7 @@ package cloudformation
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -82,3 +83,50 @@ func waitChangeSetCreated(ctx context.Context, conn *cloudformation.Client, stac
 
 	return nil, err
 }
+
+func findChangeSetResourceChangesByTwoPartKey(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) ([]awstypes.ResourceChange, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	}
+	var output []awstypes.ResourceChange
+
+	for {
+		page, err := conn.DescribeChangeSet(ctx, input)
+
+		if errs.IsA[*awstypes.ChangeSetNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Changes {
+			if v.ResourceChange != nil {
+				output = append(output, *v.ResourceChange)
+			}
+		}
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+// changeSetHasNoChanges returns whether the change set failed to be created because it contains no changes.
+func changeSetHasNoChanges(output *cloudformation.DescribeChangeSetOutput) bool {
+	if output == nil || output.Status != awstypes.ChangeSetStatusFailed {
+		return false
+	}
+
+	reason := aws.ToString(output.StatusReason)
+
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
@@ -61,6 +62,48 @@ func resourceStack() *schema.Resource {
 					ValidateDiagFunc: enum.Validate[awstypes.Capability](),
 				},
 			},
+			"change_set": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fail_on_replacement": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"logical_resource_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"resource_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrNamePrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  stackChangeSetDefaultNamePrefix,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 100),
+								validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z-]*$`), "must begin with a letter and contain only alphanumeric characters and hyphens"),
+							),
+						},
+						"preview_in_plan": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"disable_rollback": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -97,6 +140,34 @@ func resourceStack() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"policy_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -136,6 +207,7 @@ func resourceStack() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			customdiff.ComputedIf("outputs", stackHasActualChanges),
+			resourceStackCustomizeDiffChangeSet,
 		),
 	}
 }
@@ -278,7 +350,21 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
 
+	// Changing how the stack is updated doesn't change the stack.
+	if !d.HasChangesExcept("change_set", "planned_changes") {
+		return append(diags, resourceStackRead(ctx, d, meta)...)
+	}
+
 	requestToken := id.UniqueId()
+
+	if _, ok := d.GetOk("change_set"); ok {
+		if diags = updateStackWithChangeSet(ctx, conn, d, requestToken); diags.HasError() {
+			return diags
+		}
+
+		return append(diags, resourceStackRead(ctx, d, meta)...)
+	}
+
 	input := &cloudformation.UpdateStackInput{
 		ClientRequestToken: aws.String(requestToken),
 		StackName:          aws.String(d.Id()),
@@ -676,6 +762,10 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 		if attr.ForceNew {
 			continue
 		}
+		// Changing how the stack is updated doesn't change the stack.
+		if k == "change_set" {
+			continue
+		}
 		if attr.Computed && !attr.Optional {
 			continue
 		}
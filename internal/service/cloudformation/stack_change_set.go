@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	stackChangeSetDefaultNamePrefix = "terraform-"
+)
+
+type stackChangeSetConfig struct {
+	namePrefix                          string
+	previewInPlan                       bool
+	failOnReplacementLogicalResourceIDs []string
+	failOnReplacementResourceTypes      []string
+}
+
+func expandStackChangeSetConfig(tfList []any) *stackChangeSetConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &stackChangeSetConfig{
+		namePrefix: stackChangeSetDefaultNamePrefix,
+	}
+
+	tfMap, ok := tfList[0].(map[string]any)
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap[names.AttrNamePrefix].(string); ok && v != "" {
+		apiObject.namePrefix = v
+	}
+
+	if v, ok := tfMap["preview_in_plan"].(bool); ok {
+		apiObject.previewInPlan = v
+	}
+
+	if v, ok := tfMap["fail_on_replacement"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+
+		if v, ok := tfMap["logical_resource_ids"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.failOnReplacementLogicalResourceIDs = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.failOnReplacementResourceTypes = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+// checkReplacements returns an error if any of the resource changes would replace, or may replace, a protected resource.
+func (c *stackChangeSetConfig) checkReplacements(changes []awstypes.ResourceChange) error {
+	var protected []string
+
+	for _, change := range replacingResourceChanges(changes) {
+		logicalID, resourceType := aws.ToString(change.LogicalResourceId), aws.ToString(change.ResourceType)
+
+		if slices.Contains(c.failOnReplacementLogicalResourceIDs, logicalID) || slices.Contains(c.failOnReplacementResourceTypes, resourceType) {
+			protected = append(protected, resourceChangeString(change))
+		}
+	}
+
+	if len(protected) > 0 {
+		return fmt.Errorf("change set would replace protected resources: %s", strings.Join(protected, ", "))
+	}
+
+	return nil
+}
+
+// replacingResourceChanges returns the resource changes whose replacement is `True` or `Conditional`.
+func replacingResourceChanges(changes []awstypes.ResourceChange) []awstypes.ResourceChange {
+	var output []awstypes.ResourceChange
+
+	for _, change := range changes {
+		if change.Action == awstypes.ChangeActionModify && (change.Replacement == awstypes.ReplacementTrue || change.Replacement == awstypes.ReplacementConditional) {
+			output = append(output, change)
+		}
+	}
+
+	return output
+}
+
+func resourceChangeString(change awstypes.ResourceChange) string {
+	return fmt.Sprintf("%s (%s, replacement: %s)", aws.ToString(change.LogicalResourceId), aws.ToString(change.ResourceType), change.Replacement)
+}
+
+// createStackChangeSet creates an update change set for the stack and returns its resource changes.
+// No resource changes are returned if the change set contains no changes.
+func createStackChangeSet(ctx context.Context, conn *cloudformation.Client, d sdkv2.ResourceDiffer, changeSetName string, tags []awstypes.Tag) ([]awstypes.ResourceChange, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		ChangeSetType: awstypes.ChangeSetTypeUpdate,
+		StackName:     aws.String(d.Id()),
+		Tags:          tags,
+	}
+
+	// Capabilities must be present whether they are changed or not
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringyValueSet[awstypes.Capability](v.(*schema.Set))
+	}
+	if d.HasChange(names.AttrIAMRoleARN) {
+		input.RoleARN = aws.String(d.Get(names.AttrIAMRoleARN).(string))
+	}
+	if d.HasChange("notification_arns") {
+		input.NotificationARNs = flex.ExpandStringValueSet(d.Get("notification_arns").(*schema.Set))
+	}
+	// Parameters must be present whether they are changed or not
+	if v, ok := d.GetOk(names.AttrParameters); ok {
+		input.Parameters = expandParameters(v.(map[string]any))
+	}
+	// Either TemplateBody, TemplateURL or UsePreviousTemplate are required
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return nil, err
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (any, error) {
+		return conn.CreateChangeSet(ctx, input)
+	}, errCodeValidationError, "is invalid or cannot be assumed")
+
+	if err != nil {
+		return nil, fmt.Errorf("creating CloudFormation Stack (%s) change set (%s): %w", d.Id(), changeSetName, err)
+	}
+
+	output, err := waitChangeSetCreated(ctx, conn, d.Id(), changeSetName)
+
+	if changeSetHasNoChanges(output) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for CloudFormation Stack (%s) change set (%s) create: %w", d.Id(), changeSetName, err)
+	}
+
+	changes, err := findChangeSetResourceChangesByTwoPartKey(ctx, conn, d.Id(), changeSetName)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading CloudFormation Stack (%s) change set (%s): %w", d.Id(), changeSetName, err)
+	}
+
+	slices.SortFunc(changes, func(a, b awstypes.ResourceChange) int {
+		return strings.Compare(aws.ToString(a.LogicalResourceId), aws.ToString(b.LogicalResourceId))
+	})
+
+	return changes, nil
+}
+
+func deleteStackChangeSet(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) {
+	input := cloudformation.DeleteChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	}
+	_, err := conn.DeleteChangeSet(ctx, &input)
+
+	if err != nil && !tfresource.NotFound(err) {
+		log.Printf("[WARN] Error deleting CloudFormation Stack (%s) change set (%s): %s", stackID, changeSetName, err)
+	}
+}
+
+// resourceStackCustomizeDiffChangeSet shows the resource-level changes CloudFormation will make in the plan via `planned_changes`.
+// Unless `change_set.preview_in_plan` is set, `planned_changes` is unknown until apply and no AWS API calls are made.
+// Otherwise a change set is created for the planned update and deleted once it's been described.
+func resourceStackCustomizeDiffChangeSet(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	c := expandStackChangeSetConfig(d.Get("change_set").([]any))
+
+	if c == nil {
+		if v := d.Get("planned_changes").([]any); len(v) > 0 {
+			return d.SetNew("planned_changes", []any{})
+		}
+
+		return nil
+	}
+
+	if !stackHasActualChanges(ctx, d, meta) {
+		return nil
+	}
+
+	// Creating a change set modifies the stack's change set list, so plans stay read-only unless explicitly opted in.
+	if !c.previewInPlan {
+		return d.SetNewComputed("planned_changes")
+	}
+
+	for _, key := range []string{"capabilities", names.AttrIAMRoleARN, "notification_arns", names.AttrParameters, names.AttrTagsAll, "template_body", "template_url"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("planned_changes")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	tags := svcTags(tftags.New(ctx, d.Get(names.AttrTagsAll).(map[string]any)).IgnoreAWS())
+	if tags == nil {
+		tags = []awstypes.Tag{}
+	}
+
+	changeSetName := id.PrefixedUniqueId(c.namePrefix)
+	changes, err := createStackChangeSet(ctx, conn, d, changeSetName, tags)
+	deleteStackChangeSet(ctx, conn, d.Id(), changeSetName)
+
+	if err != nil {
+		return err
+	}
+
+	if err := c.checkReplacements(changes); err != nil {
+		return fmt.Errorf("CloudFormation Stack (%s): %w", d.Id(), err)
+	}
+
+	return d.SetNew("planned_changes", flattenResourceChanges(changes))
+}
+
+// updateStackWithChangeSet updates the stack by creating and executing a change set.
+// The change set is checked against the plan and `change_set.fail_on_replacement` before it's executed.
+func updateStackWithChangeSet(ctx context.Context, conn *cloudformation.Client, d *schema.ResourceData, requestToken string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := expandStackChangeSetConfig(d.Get("change_set").([]any))
+
+	tags := getTagsIn(ctx)
+	if tags == nil {
+		tags = []awstypes.Tag{}
+	}
+
+	changeSetName := id.PrefixedUniqueId(c.namePrefix)
+	changes, err := createStackChangeSet(ctx, conn, d, changeSetName, tags)
+
+	if err != nil {
+		deleteStackChangeSet(ctx, conn, d.Id(), changeSetName)
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := c.checkReplacements(changes); err != nil {
+		deleteStackChangeSet(ctx, conn, d.Id(), changeSetName)
+		return sdkdiag.AppendErrorf(diags, "updating CloudFormation Stack (%s): %s", d.Id(), err)
+	}
+
+	tfList := flattenResourceChanges(changes)
+
+	if d.GetRawPlan().GetAttr("planned_changes").IsWhollyKnown() {
+		if planned := d.Get("planned_changes").([]any); len(planned) != len(tfList) || (len(tfList) > 0 && !reflect.DeepEqual(planned, tfList)) {
+			deleteStackChangeSet(ctx, conn, d.Id(), changeSetName)
+			return sdkdiag.AppendErrorf(diags, "updating CloudFormation Stack (%s): change set (%s) differs from the planned changes, run plan again", d.Id(), changeSetName)
+		}
+	}
+
+	if d.HasChanges("policy_body", "policy_url") {
+		input := &cloudformation.SetStackPolicyInput{
+			StackName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("policy_body") {
+			policy, err := structure.NormalizeJsonString(d.Get("policy_body"))
+			if err != nil {
+				deleteStackChangeSet(ctx, conn, d.Id(), changeSetName)
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+			input.StackPolicyBody = aws.String(policy)
+		}
+		if d.HasChange("policy_url") {
+			input.StackPolicyURL = aws.String(d.Get("policy_url").(string))
+		}
+
+		if _, err := conn.SetStackPolicy(ctx, input); err != nil {
+			deleteStackChangeSet(ctx, conn, d.Id(), changeSetName)
+			return sdkdiag.AppendErrorf(diags, "setting CloudFormation Stack (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	if len(changes) == 0 {
+		// The change set failed to be created as it contains no changes.
+		deleteStackChangeSet(ctx, conn, d.Id(), changeSetName)
+		d.Set("planned_changes", tfList)
+
+		return diags
+	}
+
+	for _, change := range replacingResourceChanges(changes) {
+		diags = sdkdiag.AppendWarningf(diags, "CloudFormation Stack (%s) change set (%s) replaces resource %s", d.Id(), changeSetName, resourceChangeString(change))
+	}
+
+	input := cloudformation.ExecuteChangeSetInput{
+		ChangeSetName:      aws.String(changeSetName),
+		ClientRequestToken: aws.String(requestToken),
+		StackName:          aws.String(d.Id()),
+	}
+	if _, err := conn.ExecuteChangeSet(ctx, &input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "executing CloudFormation Stack (%s) change set (%s): %s", d.Id(), changeSetName, err)
+	}
+
+	if _, err := waitStackUpdated(ctx, conn, d.Id(), requestToken, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation Stack (%s) update: %s", d.Id(), err)
+	}
+
+	d.Set("planned_changes", tfList)
+
+	return diags
+}
+
+func flattenResourceChanges(apiObjects []awstypes.ResourceChange) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrAction:       string(apiObject.Action),
+			"logical_resource_id":  aws.ToString(apiObject.LogicalResourceId),
+			"physical_resource_id": aws.ToString(apiObject.PhysicalResourceId),
+			"replacement":          string(apiObject.Replacement),
+			names.AttrResourceType: aws.ToString(apiObject.ResourceType),
+		})
+	}
+
+	return tfList
+}
@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -572,6 +573,119 @@ func TestAccCloudFormationStack_templateUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_changeSet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_changeSet(rName, "10.0.0.0/16", "Primary_CF_VPC", `["AWS::EC2::VPC"]`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "change_set.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "change_set.0.name_prefix", "terraform-"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+				),
+			},
+			{
+				// Tag changes modify the VPC in place.
+				Config: testAccStackConfig_changeSet(rName, "10.0.0.0/16", "Updated_CF_VPC", `["AWS::EC2::VPC"]`, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								names.AttrAction:       knownvalue.StringExact("Modify"),
+								"logical_resource_id":  knownvalue.StringExact("MyVPC"),
+								"replacement":          knownvalue.StringExact("False"),
+								names.AttrResourceType: knownvalue.StringExact("AWS::EC2::VPC"),
+							}),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "1"),
+				),
+			},
+			{
+				// CIDR block changes replace the VPC.
+				Config:      testAccStackConfig_changeSet(rName, "12.0.0.0/16", "Updated_CF_VPC", `["AWS::EC2::VPC"]`, true),
+				ExpectError: regexache.MustCompile(`change set would replace protected resources: MyVPC \(AWS::EC2::VPC, replacement: True\)`),
+			},
+			{
+				Config: testAccStackConfig_changeSet(rName, "12.0.0.0/16", "Updated_CF_VPC", `[]`, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								names.AttrAction:      knownvalue.StringExact("Modify"),
+								"logical_resource_id": knownvalue.StringExact("MyVPC"),
+								"replacement":         knownvalue.StringExact("True"),
+							}),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", "12.0.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStack_changeSetNoPreview(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_changeSet(rName, "10.0.0.0/16", "Primary_CF_VPC", `["AWS::EC2::VPC"]`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "change_set.0.preview_in_plan", acctest.CtFalse),
+				),
+			},
+			{
+				// No change set is created during plan.
+				Config: testAccStackConfig_changeSet(rName, "10.0.0.0/16", "Updated_CF_VPC", `["AWS::EC2::VPC"]`, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("planned_changes")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.replacement", "False"),
+				),
+			},
+			{
+				// Protected replacements are caught by the apply-time change set before it's executed.
+				Config:      testAccStackConfig_changeSet(rName, "12.0.0.0/16", "Updated_CF_VPC", `["AWS::EC2::VPC"]`, false),
+				ExpectError: regexache.MustCompile(`change set would replace protected resources: MyVPC \(AWS::EC2::VPC, replacement: True\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckStackExists(ctx context.Context, n string, v *awstypes.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -924,6 +1038,51 @@ STACK
 `, rName, cidr)
 }
 
+func testAccStackConfig_changeSet(rName, cidr, vpcName, protectedResourceTypes string, previewInPlan bool) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+  parameters = {
+    VpcCIDR = %[2]q
+    VpcName = %[3]q
+  }
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    },
+    "VpcName" : {
+      "Description" : "Name of the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"},
+        "Tags" : [
+          {"Key": "Name", "Value": {"Ref": "VpcName"}}
+        ]
+      }
+    }
+  }
+}
+STACK
+
+  change_set {
+    preview_in_plan = %[5]t
+
+    fail_on_replacement {
+      resource_types = %[4]s
+    }
+  }
+}
+`, rName, cidr, vpcName, protectedResourceTypes, previewInPlan)
+}
+
 func testAccStackConfig_baseTemplateURL(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cognito_log_delivery_configuration", name="Log Delivery Configuration")
+func resourceLogDeliveryConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLogDeliveryConfigurationPut,
+		ReadWithoutTimeout:   resourceLogDeliveryConfigurationRead,
+		UpdateWithoutTimeout: resourceLogDeliveryConfigurationPut,
+		DeleteWithoutTimeout: resourceLogDeliveryConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_configurations": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_watch_logs_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"event_source": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.EventSourceName](),
+						},
+						"firehose_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrStreamARN: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"log_level": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.LogLevel](),
+						},
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrUserPoolID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceLogDeliveryConfigurationPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	userPoolID := d.Get(names.AttrUserPoolID).(string)
+	input := &cognitoidentityprovider.SetLogDeliveryConfigurationInput{
+		LogConfigurations: expandLogConfigurationTypes(d.Get("log_configurations").([]any)),
+		UserPoolId:        aws.String(userPoolID),
+	}
+
+	_, err := conn.SetLogDeliveryConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting Cognito Log Delivery Configuration (%s): %s", userPoolID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(userPoolID)
+	}
+
+	return append(diags, resourceLogDeliveryConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceLogDeliveryConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	logDeliveryConfiguration, err := findLogDeliveryConfigurationByUserPoolID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cognito Log Delivery Configuration %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cognito Log Delivery Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("log_configurations", flattenLogConfigurationTypes(logDeliveryConfiguration.LogConfigurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting log_configurations: %s", err)
+	}
+	d.Set(names.AttrUserPoolID, logDeliveryConfiguration.UserPoolId)
+
+	return diags
+}
+
+func resourceLogDeliveryConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Cognito Log Delivery Configuration: %s", d.Id())
+	input := cognitoidentityprovider.SetLogDeliveryConfigurationInput{
+		LogConfigurations: []awstypes.LogConfigurationType{},
+		UserPoolId:        aws.String(d.Id()),
+	}
+	_, err := conn.SetLogDeliveryConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Cognito Log Delivery Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findLogDeliveryConfigurationByUserPoolID(ctx context.Context, conn *cognitoidentityprovider.Client, userPoolID string) (*awstypes.LogDeliveryConfigurationType, error) {
+	input := &cognitoidentityprovider.GetLogDeliveryConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	output, err := conn.GetLogDeliveryConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A user pool without log delivery returns an empty configuration rather than an error.
+	if output == nil || output.LogDeliveryConfiguration == nil || len(output.LogDeliveryConfiguration.LogConfigurations) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LogDeliveryConfiguration, nil
+}
+
+func expandLogConfigurationTypes(tfList []any) []awstypes.LogConfigurationType {
+	apiObjects := make([]awstypes.LogConfigurationType, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.LogConfigurationType{
+			EventSource: awstypes.EventSourceName(tfMap["event_source"].(string)),
+			LogLevel:    awstypes.LogLevel(tfMap["log_level"].(string)),
+		}
+
+		if v, ok := tfMap["cloud_watch_logs_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]any)["log_group_arn"].(string); ok && v != "" {
+				apiObject.CloudWatchLogsConfiguration = &awstypes.CloudWatchLogsConfigurationType{
+					LogGroupArn: aws.String(v),
+				}
+			}
+		}
+
+		if v, ok := tfMap["firehose_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]any)[names.AttrStreamARN].(string); ok && v != "" {
+				apiObject.FirehoseConfiguration = &awstypes.FirehoseConfigurationType{
+					StreamArn: aws.String(v),
+				}
+			}
+		}
+
+		if v, ok := tfMap["s3_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]any)["bucket_arn"].(string); ok && v != "" {
+				apiObject.S3Configuration = &awstypes.S3ConfigurationType{
+					BucketArn: aws.String(v),
+				}
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLogConfigurationTypes(apiObjects []awstypes.LogConfigurationType) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"event_source": apiObject.EventSource,
+			"log_level":    apiObject.LogLevel,
+		}
+
+		if v := apiObject.CloudWatchLogsConfiguration; v != nil {
+			tfMap["cloud_watch_logs_configuration"] = []any{map[string]any{
+				"log_group_arn": aws.ToString(v.LogGroupArn),
+			}}
+		}
+
+		if v := apiObject.FirehoseConfiguration; v != nil {
+			tfMap["firehose_configuration"] = []any{map[string]any{
+				names.AttrStreamARN: aws.ToString(v.StreamArn),
+			}}
+		}
+
+		if v := apiObject.S3Configuration; v != nil {
+			tfMap["s3_configuration"] = []any{map[string]any{
+				"bucket_arn": aws.ToString(v.BucketArn),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
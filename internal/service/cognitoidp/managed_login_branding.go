@@ -0,0 +1,405 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cognito_managed_login_branding", name="Managed Login Branding")
+func resourceManagedLoginBranding() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceManagedLoginBrandingCreate,
+		ReadWithoutTimeout:   resourceManagedLoginBrandingRead,
+		UpdateWithoutTimeout: resourceManagedLoginBrandingUpdate,
+		DeleteWithoutTimeout: resourceManagedLoginBrandingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asset": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      40,
+				ConflictsWith: []string{"use_cognito_provided_values"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidBase64String,
+							// Only a hash of the asset content is stored in state.
+							StateFunc: func(v any) string {
+								return managedLoginBrandingAssetHash(v.(string))
+							},
+						},
+						"category": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AssetCategoryType](),
+						},
+						"color_mode": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ColorSchemeModeType](),
+						},
+						"extension": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.AssetExtensionType](),
+						},
+						names.AttrResourceID: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrClientID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"managed_login_branding_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"settings": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v any) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				ConflictsWith: []string{"use_cognito_provided_values"},
+			},
+			"use_cognito_provided_values": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"asset", "settings"},
+			},
+			names.AttrUserPoolID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	managedLoginBrandingResourceIDPartCount = 2
+)
+
+func resourceManagedLoginBrandingCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	userPoolID, clientID := d.Get(names.AttrUserPoolID).(string), d.Get(names.AttrClientID).(string)
+	id, err := flex.FlattenResourceId([]string{userPoolID, clientID}, managedLoginBrandingResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &cognitoidentityprovider.CreateManagedLoginBrandingInput{
+		ClientId:                 aws.String(clientID),
+		UseCognitoProvidedValues: d.Get("use_cognito_provided_values").(bool),
+		UserPoolId:               aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("asset"); ok && len(v.([]any)) > 0 {
+		assets, err := expandAssetTypes(v.([]any))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		input.Assets = assets
+	}
+
+	if v, ok := d.GetOk("settings"); ok {
+		settings, err := json.SmithyDocumentFromString(v.(string), document.NewLazyDocument)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		input.Settings = settings
+	}
+
+	_, err = conn.CreateManagedLoginBranding(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Cognito Managed Login Branding (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceManagedLoginBrandingRead(ctx, d, meta)...)
+}
+
+func resourceManagedLoginBrandingRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), managedLoginBrandingResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	userPoolID, clientID := parts[0], parts[1]
+
+	branding, err := findManagedLoginBrandingByTwoPartKey(ctx, conn, userPoolID, clientID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cognito Managed Login Branding %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Cognito Managed Login Branding (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("asset", flattenAssetTypes(branding.Assets, d.Get("asset").([]any))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset: %s", err)
+	}
+	d.Set(names.AttrClientID, clientID)
+	if v := branding.CreationDate; v != nil {
+		d.Set(names.AttrCreationDate, aws.ToTime(v).Format(time.RFC3339))
+	}
+	if v := branding.LastModifiedDate; v != nil {
+		d.Set("last_modified_date", aws.ToTime(v).Format(time.RFC3339))
+	}
+	d.Set("managed_login_branding_id", branding.ManagedLoginBrandingId)
+	if branding.Settings != nil {
+		v, err := json.SmithyDocumentToString(branding.Settings)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("settings", v)
+	} else {
+		d.Set("settings", nil)
+	}
+	d.Set("use_cognito_provided_values", branding.UseCognitoProvidedValues)
+	d.Set(names.AttrUserPoolID, branding.UserPoolId)
+
+	return diags
+}
+
+func resourceManagedLoginBrandingUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	input := &cognitoidentityprovider.UpdateManagedLoginBrandingInput{
+		ManagedLoginBrandingId:   aws.String(d.Get("managed_login_branding_id").(string)),
+		UseCognitoProvidedValues: d.Get("use_cognito_provided_values").(bool),
+		UserPoolId:               aws.String(d.Get(names.AttrUserPoolID).(string)),
+	}
+
+	if v, ok := d.GetOk("asset"); ok && len(v.([]any)) > 0 {
+		assets, err := expandAssetTypes(v.([]any))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		input.Assets = assets
+	}
+
+	if v, ok := d.GetOk("settings"); ok {
+		settings, err := json.SmithyDocumentFromString(v.(string), document.NewLazyDocument)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		input.Settings = settings
+	}
+
+	_, err := conn.UpdateManagedLoginBranding(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Cognito Managed Login Branding (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceManagedLoginBrandingRead(ctx, d, meta)...)
+}
+
+func resourceManagedLoginBrandingDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIDPClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Cognito Managed Login Branding: %s", d.Id())
+	input := cognitoidentityprovider.DeleteManagedLoginBrandingInput{
+		ManagedLoginBrandingId: aws.String(d.Get("managed_login_branding_id").(string)),
+		UserPoolId:             aws.String(d.Get(names.AttrUserPoolID).(string)),
+	}
+	_, err := conn.DeleteManagedLoginBranding(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Cognito Managed Login Branding (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findManagedLoginBrandingByTwoPartKey(ctx context.Context, conn *cognitoidentityprovider.Client, userPoolID, clientID string) (*awstypes.ManagedLoginBrandingType, error) {
+	input := &cognitoidentityprovider.DescribeManagedLoginBrandingByClientInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	output, err := conn.DescribeManagedLoginBrandingByClient(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ManagedLoginBranding == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ManagedLoginBranding, nil
+}
+
+// managedLoginBrandingAssetHash returns the SHA-256 hash of the decoded asset content.
+// Values that are not valid base64 are hashed as-is.
+func managedLoginBrandingAssetHash(s string) string {
+	b, err := itypes.Base64Decode(s)
+	if err != nil {
+		b = []byte(s)
+	}
+
+	return managedLoginBrandingAssetBytesHash(b)
+}
+
+func managedLoginBrandingAssetBytesHash(b []byte) string {
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:])
+}
+
+func managedLoginBrandingAssetKey(category, colorMode string) string {
+	return category + ":" + colorMode
+}
+
+func expandAssetTypes(tfList []any) ([]awstypes.AssetType, error) {
+	apiObjects := make([]awstypes.AssetType, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.AssetType{
+			Category:  awstypes.AssetCategoryType(tfMap["category"].(string)),
+			ColorMode: awstypes.ColorSchemeModeType(tfMap["color_mode"].(string)),
+			Extension: awstypes.AssetExtensionType(tfMap["extension"].(string)),
+		}
+
+		if v, ok := tfMap["bytes"].(string); ok && v != "" {
+			b, err := itypes.Base64Decode(v)
+			if err != nil {
+				return nil, err
+			}
+			apiObject.Bytes = b
+		}
+
+		if v, ok := tfMap[names.AttrResourceID].(string); ok && v != "" {
+			apiObject.ResourceId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+// flattenAssetTypes flattens the API's assets, preserving the order of any
+// assets already present in configuration or state.
+func flattenAssetTypes(apiObjects []awstypes.AssetType, tfListOld []any) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	byKey := make(map[string]awstypes.AssetType, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		byKey[managedLoginBrandingAssetKey(string(apiObject.Category), string(apiObject.ColorMode))] = apiObject
+	}
+
+	tfList := make([]any, 0, len(apiObjects))
+	flatten := func(apiObject awstypes.AssetType) map[string]any {
+		return map[string]any{
+			"bytes":              managedLoginBrandingAssetBytesHash(apiObject.Bytes),
+			"category":           apiObject.Category,
+			"color_mode":         apiObject.ColorMode,
+			"extension":          apiObject.Extension,
+			names.AttrResourceID: aws.ToString(apiObject.ResourceId),
+		}
+	}
+
+	for _, tfMapRaw := range tfListOld {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		key := managedLoginBrandingAssetKey(tfMap["category"].(string), tfMap["color_mode"].(string))
+		if apiObject, ok := byKey[key]; ok {
+			tfList = append(tfList, flatten(apiObject))
+			delete(byKey, key)
+		}
+	}
+
+	for _, apiObject := range apiObjects {
+		if _, ok := byKey[managedLoginBrandingAssetKey(string(apiObject.Category), string(apiObject.ColorMode))]; ok {
+			tfList = append(tfList, flatten(apiObject))
+		}
+	}
+
+	return tfList
+}
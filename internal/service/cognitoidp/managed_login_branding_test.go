@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcognitoidp "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCognitoIDPManagedLoginBranding_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_managed_login_branding.test"
+	clientResourceName := "aws_cognito_user_pool_client.test"
+	userPoolResourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedLoginBrandingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedLoginBrandingConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedLoginBrandingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "asset.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClientID, clientResourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "managed_login_branding_id"),
+					resource.TestCheckResourceAttr(resourceName, "use_cognito_provided_values", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrUserPoolID, userPoolResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPManagedLoginBranding_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_managed_login_branding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedLoginBrandingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedLoginBrandingConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedLoginBrandingExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcognitoidp.ResourceManagedLoginBranding(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPManagedLoginBranding_settingsAndAssets(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_managed_login_branding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckManagedLoginBrandingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedLoginBrandingConfig_settingsAndAssets(rName, "logo.png", "LIGHT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedLoginBrandingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "asset.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "asset.0.bytes"),
+					resource.TestCheckResourceAttr(resourceName, "asset.0.category", "FORM_LOGO"),
+					resource.TestCheckResourceAttr(resourceName, "asset.0.color_mode", "LIGHT"),
+					resource.TestCheckResourceAttr(resourceName, "asset.0.extension", "PNG"),
+					resource.TestCheckResourceAttrSet(resourceName, "settings"),
+					resource.TestCheckResourceAttr(resourceName, "use_cognito_provided_values", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccManagedLoginBrandingConfig_settingsAndAssets(rName, "logo_modified.png", "DARK"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManagedLoginBrandingExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "asset.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "asset.0.color_mode", "DARK"),
+					resource.TestCheckResourceAttrSet(resourceName, "settings"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckManagedLoginBrandingDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cognito_managed_login_branding" {
+				continue
+			}
+
+			_, err := tfcognitoidp.FindManagedLoginBrandingByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrUserPoolID], rs.Primary.Attributes[names.AttrClientID])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Cognito Managed Login Branding %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckManagedLoginBrandingExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPClient(ctx)
+
+		_, err := tfcognitoidp.FindManagedLoginBrandingByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrUserPoolID], rs.Primary.Attributes[names.AttrClientID])
+
+		return err
+	}
+}
+
+func testAccManagedLoginBrandingConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain                = %[1]q
+  managed_login_version = 2
+  user_pool_id          = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+`, rName)
+}
+
+func testAccManagedLoginBrandingConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccManagedLoginBrandingConfig_base(rName), `
+resource "aws_cognito_managed_login_branding" "test" {
+  client_id                   = aws_cognito_user_pool_client.test.id
+  use_cognito_provided_values = true
+
+  # Refer to the aws_cognito_user_pool_domain resource's
+  # user_pool_id attribute to ensure it is in an 'Active' state
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+}
+`)
+}
+
+func testAccManagedLoginBrandingConfig_settingsAndAssets(rName, filename, colorMode string) string {
+	return acctest.ConfigCompose(testAccManagedLoginBrandingConfig_base(rName), fmt.Sprintf(`
+resource "aws_cognito_managed_login_branding" "test" {
+  client_id    = aws_cognito_user_pool_client.test.id
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+
+  settings = jsonencode({
+    categories = {
+      global = {
+        colorSchemeMode = %[2]q
+      }
+    }
+  })
+
+  asset {
+    bytes      = filebase64("test-fixtures/%[1]s")
+    category   = "FORM_LOGO"
+    color_mode = %[2]q
+    extension  = "PNG"
+  }
+}
+`, filename, colorMode))
+}
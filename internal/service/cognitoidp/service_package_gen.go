@@ -81,6 +81,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_cognito_identity_provider",
 			Name:     "Identity Provider",
 		},
+		{
+			Factory:  resourceLogDeliveryConfiguration,
+			TypeName: "aws_cognito_log_delivery_configuration",
+			Name:     "Log Delivery Configuration",
+		},
+		{
+			Factory:  resourceManagedLoginBranding,
+			TypeName: "aws_cognito_managed_login_branding",
+			Name:     "Managed Login Branding",
+		},
 		{
 			Factory:  resourceResourceServer,
 			TypeName: "aws_cognito_resource_server",
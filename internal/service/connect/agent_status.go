@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_agent_status", name="Agent Status")
+// @Tags(identifierAttribute="arn")
+func resourceAgentStatus() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAgentStatusCreate,
+		ReadWithoutTimeout:   resourceAgentStatusRead,
+		UpdateWithoutTimeout: resourceAgentStatusUpdate,
+		DeleteWithoutTimeout: resourceAgentStatusDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_status_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"display_order": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			names.AttrState: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.AgentStatusState](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAgentStatusCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	name := d.Get(names.AttrName).(string)
+	input := &connect.CreateAgentStatusInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		State:      awstypes.AgentStatusState(d.Get(names.AttrState).(string)),
+		Tags:       getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_order"); ok {
+		input.DisplayOrder = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := conn.CreateAgentStatus(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Agent Status (%s): %s", name, err)
+	}
+
+	id := agentStatusCreateResourceID(instanceID, aws.ToString(output.AgentStatusId))
+	d.SetId(id)
+
+	return append(diags, resourceAgentStatusRead(ctx, d, meta)...)
+}
+
+func resourceAgentStatusRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, agentStatusID, err := agentStatusParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	agentStatus, err := findAgentStatusByTwoPartKey(ctx, conn, instanceID, agentStatusID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Agent Status (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Agent Status (%s): %s", d.Id(), err)
+	}
+
+	d.Set("agent_status_id", agentStatus.AgentStatusId)
+	d.Set(names.AttrARN, agentStatus.AgentStatusARN)
+	d.Set(names.AttrDescription, agentStatus.Description)
+	d.Set("display_order", agentStatus.DisplayOrder)
+	d.Set(names.AttrInstanceID, instanceID)
+	d.Set(names.AttrName, agentStatus.Name)
+	d.Set(names.AttrState, agentStatus.State)
+	d.Set(names.AttrType, agentStatus.Type)
+
+	setTagsOut(ctx, agentStatus.Tags)
+
+	return diags
+}
+
+func resourceAgentStatusUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, agentStatusID, err := agentStatusParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges(names.AttrDescription, "display_order", names.AttrName, names.AttrState) {
+		input := &connect.UpdateAgentStatusInput{
+			AgentStatusId: aws.String(agentStatusID),
+			Description:   aws.String(d.Get(names.AttrDescription).(string)),
+			InstanceId:    aws.String(instanceID),
+			Name:          aws.String(d.Get(names.AttrName).(string)),
+			State:         awstypes.AgentStatusState(d.Get(names.AttrState).(string)),
+		}
+
+		if v, ok := d.GetOk("display_order"); ok {
+			input.DisplayOrder = aws.Int32(int32(v.(int)))
+		}
+
+		_, err = conn.UpdateAgentStatus(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect Agent Status (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAgentStatusRead(ctx, d, meta)...)
+}
+
+func resourceAgentStatusDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, agentStatusID, err := agentStatusParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Amazon Connect does not support deleting agent statuses, so disable the status instead.
+	log.Printf("[DEBUG] Disabling Connect Agent Status: %s", d.Id())
+	input := connect.UpdateAgentStatusInput{
+		AgentStatusId: aws.String(agentStatusID),
+		InstanceId:    aws.String(instanceID),
+		State:         awstypes.AgentStatusStateDisabled,
+	}
+	_, err = conn.UpdateAgentStatus(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "disabling Connect Agent Status (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const agentStatusResourceIDSeparator = ":"
+
+func agentStatusCreateResourceID(instanceID, agentStatusID string) string {
+	parts := []string{instanceID, agentStatusID}
+	id := strings.Join(parts, agentStatusResourceIDSeparator)
+
+	return id
+}
+
+func agentStatusParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, agentStatusResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected instanceID%[2]sagentStatusID", id, agentStatusResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findAgentStatusByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, agentStatusID string) (*awstypes.AgentStatus, error) {
+	input := &connect.DescribeAgentStatusInput{
+		AgentStatusId: aws.String(agentStatusID),
+		InstanceId:    aws.String(instanceID),
+	}
+
+	return findAgentStatus(ctx, conn, input)
+}
+
+func findAgentStatus(ctx context.Context, conn *connect.Client, input *connect.DescribeAgentStatusInput) (*awstypes.AgentStatus, error) {
+	output, err := conn.DescribeAgentStatus(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AgentStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AgentStatus, nil
+}
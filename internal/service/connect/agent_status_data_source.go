@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_connect_agent_status", name="Agent Status")
+// @Tags
+func dataSourceAgentStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAgentStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"agent_status_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"agent_status_id", names.AttrName},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_order": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrName, "agent_status_id"},
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAgentStatusRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	input := &connect.DescribeAgentStatusInput{
+		InstanceId: aws.String(instanceID),
+	}
+
+	if v, ok := d.GetOk("agent_status_id"); ok {
+		input.AgentStatusId = aws.String(v.(string))
+	} else if v, ok := d.GetOk(names.AttrName); ok {
+		name := v.(string)
+		agentStatusSummary, err := findAgentStatusSummaryByTwoPartKey(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Connect Agent Status (%s) summary: %s", name, err)
+		}
+
+		input.AgentStatusId = agentStatusSummary.Id
+	}
+
+	agentStatus, err := findAgentStatus(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Agent Status: %s", err)
+	}
+
+	agentStatusID := aws.ToString(agentStatus.AgentStatusId)
+	id := agentStatusCreateResourceID(instanceID, agentStatusID)
+	d.SetId(id)
+	d.Set("agent_status_id", agentStatusID)
+	d.Set(names.AttrARN, agentStatus.AgentStatusARN)
+	d.Set(names.AttrDescription, agentStatus.Description)
+	d.Set("display_order", agentStatus.DisplayOrder)
+	d.Set(names.AttrInstanceID, instanceID)
+	d.Set(names.AttrName, agentStatus.Name)
+	d.Set(names.AttrState, agentStatus.State)
+	d.Set(names.AttrType, agentStatus.Type)
+
+	setTagsOut(ctx, agentStatus.Tags)
+
+	return diags
+}
+
+func findAgentStatusSummaryByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, name string) (*awstypes.AgentStatusSummary, error) {
+	const maxResults = 60
+	input := &connect.ListAgentStatusesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int32(maxResults),
+	}
+
+	return findAgentStatusSummary(ctx, conn, input, func(v *awstypes.AgentStatusSummary) bool {
+		return aws.ToString(v.Name) == name
+	})
+}
+
+func findAgentStatusSummary(ctx context.Context, conn *connect.Client, input *connect.ListAgentStatusesInput, filter tfslices.Predicate[*awstypes.AgentStatusSummary]) (*awstypes.AgentStatusSummary, error) {
+	output, err := findAgentStatusSummaries(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findAgentStatusSummaries(ctx context.Context, conn *connect.Client, input *connect.ListAgentStatusesInput, filter tfslices.Predicate[*awstypes.AgentStatusSummary]) ([]awstypes.AgentStatusSummary, error) {
+	var output []awstypes.AgentStatusSummary
+
+	pages := connect.NewListAgentStatusesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AgentStatusSummaryList {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -26,6 +26,12 @@ func TestAccConnect_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"AgentStatus": {
+			acctest.CtBasic:      testAccAgentStatus_basic,
+			acctest.CtDisappears: testAccAgentStatus_disappears,
+			"dataSource_id":      testAccAgentStatusDataSource_agentStatusID,
+			"dataSource_name":    testAccAgentStatusDataSource_name,
+		},
 		"BotAssociation": {
 			acctest.CtBasic:      testAccBotAssociation_basic,
 			acctest.CtDisappears: testAccBotAssociation_disappears,
@@ -45,6 +51,13 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_id":      testAccContactFlowModuleDataSource_contactFlowModuleID,
 			"dataSource_name":    testAccContactFlowModuleDataSource_name,
 		},
+		"EvaluationForm": {
+			acctest.CtBasic:      testAccEvaluationForm_basic,
+			acctest.CtDisappears: testAccEvaluationForm_disappears,
+			"update":             testAccEvaluationForm_update,
+			"dataSource_id":      testAccEvaluationFormDataSource_evaluationFormID,
+			"dataSource_title":   testAccEvaluationFormDataSource_title,
+		},
 		"HoursOfOperation": {
 			acctest.CtBasic:      testAccHoursOfOperation_basic,
 			acctest.CtDisappears: testAccHoursOfOperation_disappears,
@@ -89,6 +102,11 @@ func TestAccConnect_serial(t *testing.T) {
 			"prefix":             testAccPhoneNumber_prefix,
 			"targetARN":          testAccPhoneNumber_targetARN,
 		},
+		"PredefinedAttribute": {
+			acctest.CtBasic:      testAccPredefinedAttribute_basic,
+			acctest.CtDisappears: testAccPredefinedAttribute_disappears,
+			"dataSource_name":    testAccPredefinedAttributeDataSource_name,
+		},
 		"Prompt": {
 			"dataSource_name": testAccPromptDataSource_name,
 		},
@@ -123,6 +141,13 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_id":                testAccRoutingProfileDataSource_routingProfileID,
 			"dataSource_name":              testAccRoutingProfileDataSource_name,
 		},
+		"Rule": {
+			acctest.CtBasic:      testAccRule_basic,
+			acctest.CtDisappears: testAccRule_disappears,
+			"taskAction":         testAccRule_taskAction,
+			"dataSource_id":      testAccRuleDataSource_ruleID,
+			"dataSource_name":    testAccRuleDataSource_name,
+		},
 		"SecurityProfile": {
 			acctest.CtBasic:      testAccSecurityProfile_basic,
 			acctest.CtDisappears: testAccSecurityProfile_disappears,
@@ -131,6 +156,13 @@ func TestAccConnect_serial(t *testing.T) {
 			"dataSource_id":      testAccSecurityProfileDataSource_securityProfileID,
 			"dataSource_name":    testAccSecurityProfileDataSource_name,
 		},
+		"TaskTemplate": {
+			acctest.CtBasic:      testAccTaskTemplate_basic,
+			acctest.CtDisappears: testAccTaskTemplate_disappears,
+			"update":             testAccTaskTemplate_update,
+			"dataSource_id":      testAccTaskTemplateDataSource_taskTemplateID,
+			"dataSource_name":    testAccTaskTemplateDataSource_name,
+		},
 		"User": {
 			acctest.CtBasic:      testAccUser_basic,
 			acctest.CtDisappears: testAccUser_disappears,
@@ -156,6 +188,16 @@ func TestAccConnect_serial(t *testing.T) {
 			acctest.CtDisappears: testAccUserHierarchyStructure_disappears,
 			"dataSource_id":      testAccUserHierarchyStructureDataSource_instanceID,
 		},
+		"View": {
+			acctest.CtBasic:      testAccView_basic,
+			acctest.CtDisappears: testAccView_disappears,
+			"dataSource_id":      testAccViewDataSource_viewID,
+			"dataSource_name":    testAccViewDataSource_name,
+		},
+		"ViewVersion": {
+			acctest.CtBasic:      testAccViewVersion_basic,
+			acctest.CtDisappears: testAccViewVersion_disappears,
+		},
 		"Vocabulary": {
 			acctest.CtBasic:      testAccVocabulary_basic,
 			acctest.CtDisappears: testAccVocabulary_disappears,
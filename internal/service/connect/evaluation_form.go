@@ -0,0 +1,737 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_evaluation_form", name="Evaluation Form")
+func resourceEvaluationForm() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEvaluationFormCreate,
+		ReadWithoutTimeout:   resourceEvaluationFormRead,
+		UpdateWithoutTimeout: resourceEvaluationFormUpdate,
+		DeleteWithoutTimeout: resourceEvaluationFormDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"evaluation_form_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_form_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"scoring_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMode: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.EvaluationFormScoringMode](),
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.EvaluationFormScoringStatus](),
+						},
+					},
+				},
+			},
+			"section": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 200,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instructions": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+						"question": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instructions": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+									"not_applicable_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"numeric_properties": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max_value": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+												"min_value": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+												"option": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 10,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"automatic_fail": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																Default:  false,
+															},
+															"max_value": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"min_value": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"score": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ValidateFunc: validation.IntBetween(0, 10),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"question_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.EvaluationFormQuestionType](),
+									},
+									"ref_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 40),
+									},
+									"single_select_properties": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"display_as": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.EvaluationFormSingleSelectQuestionDisplayMode](),
+												},
+												"option": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 2,
+													MaxItems: 256,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"automatic_fail": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																Default:  false,
+															},
+															"ref_id": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 40),
+															},
+															"score": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ValidateFunc: validation.IntBetween(0, 10),
+															},
+															"text": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"title": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 350),
+									},
+									names.AttrWeight: {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+						},
+						"ref_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 40),
+						},
+						"title": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						names.AttrWeight: {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.EvaluationFormVersionStatusActive,
+				ValidateDiagFunc: enum.Validate[awstypes.EvaluationFormVersionStatus](),
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceEvaluationFormCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	title := d.Get("title").(string)
+	input := &connect.CreateEvaluationFormInput{
+		ClientToken: aws.String(id.UniqueId()),
+		InstanceId:  aws.String(instanceID),
+		Items:       expandEvaluationFormSections(d.Get("section").([]any)),
+		Title:       aws.String(title),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scoring_strategy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.ScoringStrategy = expandEvaluationFormScoringStrategy(v.([]any)[0].(map[string]any))
+	}
+
+	output, err := conn.CreateEvaluationForm(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Evaluation Form (%s): %s", title, err)
+	}
+
+	evaluationFormID := aws.ToString(output.EvaluationFormId)
+	id := evaluationFormCreateResourceID(instanceID, evaluationFormID)
+	d.SetId(id)
+
+	// New evaluation forms are created in draft.
+	if awstypes.EvaluationFormVersionStatus(d.Get(names.AttrStatus).(string)) == awstypes.EvaluationFormVersionStatusActive {
+		const version = 1
+		if err := activateEvaluationForm(ctx, conn, instanceID, evaluationFormID, version); err != nil {
+			return sdkdiag.AppendErrorf(diags, "activating Connect Evaluation Form (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEvaluationFormRead(ctx, d, meta)...)
+}
+
+func resourceEvaluationFormRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, evaluationFormID, err := evaluationFormParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	evaluationForm, err := findEvaluationFormByTwoPartKey(ctx, conn, instanceID, evaluationFormID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Evaluation Form (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Evaluation Form (%s): %s", d.Id(), err)
+	}
+
+	if err := resourceEvaluationFormFlatten(d, evaluationForm); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set(names.AttrInstanceID, instanceID)
+
+	return diags
+}
+
+func resourceEvaluationFormUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, evaluationFormID, err := evaluationFormParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	version := int32(d.Get("evaluation_form_version").(int))
+
+	if d.HasChanges(names.AttrDescription, "scoring_strategy", "section", "title") {
+		// Active versions are locked and can only be changed by creating a new version.
+		input := &connect.UpdateEvaluationFormInput{
+			CreateNewVersion:      aws.Bool(d.Get("locked").(bool)),
+			Description:           aws.String(d.Get(names.AttrDescription).(string)),
+			EvaluationFormId:      aws.String(evaluationFormID),
+			EvaluationFormVersion: version,
+			InstanceId:            aws.String(instanceID),
+			Items:                 expandEvaluationFormSections(d.Get("section").([]any)),
+			Title:                 aws.String(d.Get("title").(string)),
+		}
+
+		if v, ok := d.GetOk("scoring_strategy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.ScoringStrategy = expandEvaluationFormScoringStrategy(v.([]any)[0].(map[string]any))
+		}
+
+		output, err := conn.UpdateEvaluationForm(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect Evaluation Form (%s): %s", d.Id(), err)
+		}
+
+		version = output.EvaluationFormVersion
+
+		if awstypes.EvaluationFormVersionStatus(d.Get(names.AttrStatus).(string)) == awstypes.EvaluationFormVersionStatusActive {
+			if err := activateEvaluationForm(ctx, conn, instanceID, evaluationFormID, version); err != nil {
+				return sdkdiag.AppendErrorf(diags, "activating Connect Evaluation Form (%s): %s", d.Id(), err)
+			}
+		}
+	} else if d.HasChange(names.AttrStatus) {
+		switch awstypes.EvaluationFormVersionStatus(d.Get(names.AttrStatus).(string)) {
+		case awstypes.EvaluationFormVersionStatusActive:
+			if err := activateEvaluationForm(ctx, conn, instanceID, evaluationFormID, version); err != nil {
+				return sdkdiag.AppendErrorf(diags, "activating Connect Evaluation Form (%s): %s", d.Id(), err)
+			}
+		case awstypes.EvaluationFormVersionStatusDraft:
+			input := &connect.DeactivateEvaluationFormInput{
+				EvaluationFormId:      aws.String(evaluationFormID),
+				EvaluationFormVersion: version,
+				InstanceId:            aws.String(instanceID),
+			}
+
+			_, err := conn.DeactivateEvaluationForm(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "deactivating Connect Evaluation Form (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceEvaluationFormRead(ctx, d, meta)...)
+}
+
+func resourceEvaluationFormDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, evaluationFormID, err := evaluationFormParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Omitting the version deletes all versions of the evaluation form.
+	log.Printf("[DEBUG] Deleting Connect Evaluation Form: %s", d.Id())
+	input := connect.DeleteEvaluationFormInput{
+		EvaluationFormId: aws.String(evaluationFormID),
+		InstanceId:       aws.String(instanceID),
+	}
+	_, err = conn.DeleteEvaluationForm(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect Evaluation Form (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceEvaluationFormFlatten(d *schema.ResourceData, evaluationForm *awstypes.EvaluationForm) error {
+	d.Set(names.AttrARN, evaluationForm.EvaluationFormArn)
+	d.Set(names.AttrDescription, evaluationForm.Description)
+	d.Set("evaluation_form_id", evaluationForm.EvaluationFormId)
+	d.Set("evaluation_form_version", evaluationForm.EvaluationFormVersion)
+	d.Set("locked", evaluationForm.Locked)
+	if err := d.Set("scoring_strategy", flattenEvaluationFormScoringStrategy(evaluationForm.ScoringStrategy)); err != nil {
+		return fmt.Errorf("setting scoring_strategy: %w", err)
+	}
+	if err := d.Set("section", flattenEvaluationFormSections(evaluationForm.Items)); err != nil {
+		return fmt.Errorf("setting section: %w", err)
+	}
+	d.Set(names.AttrStatus, evaluationForm.Status)
+	d.Set("title", evaluationForm.Title)
+
+	return nil
+}
+
+func activateEvaluationForm(ctx context.Context, conn *connect.Client, instanceID, evaluationFormID string, version int32) error {
+	input := &connect.ActivateEvaluationFormInput{
+		EvaluationFormId:      aws.String(evaluationFormID),
+		EvaluationFormVersion: version,
+		InstanceId:            aws.String(instanceID),
+	}
+
+	_, err := conn.ActivateEvaluationForm(ctx, input)
+
+	return err
+}
+
+const evaluationFormResourceIDSeparator = ":"
+
+func evaluationFormCreateResourceID(instanceID, evaluationFormID string) string {
+	parts := []string{instanceID, evaluationFormID}
+	id := strings.Join(parts, evaluationFormResourceIDSeparator)
+
+	return id
+}
+
+func evaluationFormParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, evaluationFormResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected instanceID%[2]sevaluationFormID", id, evaluationFormResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findEvaluationFormByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, evaluationFormID string) (*awstypes.EvaluationForm, error) {
+	input := &connect.DescribeEvaluationFormInput{
+		EvaluationFormId: aws.String(evaluationFormID),
+		InstanceId:       aws.String(instanceID),
+	}
+
+	return findEvaluationForm(ctx, conn, input)
+}
+
+func findEvaluationForm(ctx context.Context, conn *connect.Client, input *connect.DescribeEvaluationFormInput) (*awstypes.EvaluationForm, error) {
+	output, err := conn.DescribeEvaluationForm(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EvaluationForm == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EvaluationForm, nil
+}
+
+func expandEvaluationFormScoringStrategy(tfMap map[string]any) *awstypes.EvaluationFormScoringStrategy {
+	return &awstypes.EvaluationFormScoringStrategy{
+		Mode:   awstypes.EvaluationFormScoringMode(tfMap[names.AttrMode].(string)),
+		Status: awstypes.EvaluationFormScoringStatus(tfMap[names.AttrStatus].(string)),
+	}
+}
+
+func flattenEvaluationFormScoringStrategy(apiObject *awstypes.EvaluationFormScoringStrategy) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		names.AttrMode:   apiObject.Mode,
+		names.AttrStatus: apiObject.Status,
+	}
+
+	return []any{tfMap}
+}
+
+func expandEvaluationFormSections(tfList []any) []awstypes.EvaluationFormItem {
+	apiObjects := []awstypes.EvaluationFormItem{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		section := awstypes.EvaluationFormSection{
+			Items: expandEvaluationFormQuestions(tfMap["question"].([]any)),
+			RefId: aws.String(tfMap["ref_id"].(string)),
+			Title: aws.String(tfMap["title"].(string)),
+		}
+
+		if v, ok := tfMap["instructions"].(string); ok && v != "" {
+			section.Instructions = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrWeight].(float64); ok && v != 0 {
+			section.Weight = v
+		}
+
+		apiObjects = append(apiObjects, &awstypes.EvaluationFormItemMemberSection{Value: section})
+	}
+
+	return apiObjects
+}
+
+func expandEvaluationFormQuestions(tfList []any) []awstypes.EvaluationFormItem {
+	apiObjects := []awstypes.EvaluationFormItem{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		question := awstypes.EvaluationFormQuestion{
+			NotApplicableEnabled: tfMap["not_applicable_enabled"].(bool),
+			QuestionType:         awstypes.EvaluationFormQuestionType(tfMap["question_type"].(string)),
+			RefId:                aws.String(tfMap["ref_id"].(string)),
+			Title:                aws.String(tfMap["title"].(string)),
+		}
+
+		if v, ok := tfMap["instructions"].(string); ok && v != "" {
+			question.Instructions = aws.String(v)
+		}
+
+		if v, ok := tfMap["numeric_properties"].([]any); ok && len(v) > 0 && v[0] != nil {
+			question.QuestionTypeProperties = &awstypes.EvaluationFormQuestionTypePropertiesMemberNumeric{
+				Value: expandEvaluationFormNumericQuestionProperties(v[0].(map[string]any)),
+			}
+		}
+
+		if v, ok := tfMap["single_select_properties"].([]any); ok && len(v) > 0 && v[0] != nil {
+			question.QuestionTypeProperties = &awstypes.EvaluationFormQuestionTypePropertiesMemberSingleSelect{
+				Value: expandEvaluationFormSingleSelectQuestionProperties(v[0].(map[string]any)),
+			}
+		}
+
+		if v, ok := tfMap[names.AttrWeight].(float64); ok && v != 0 {
+			question.Weight = v
+		}
+
+		apiObjects = append(apiObjects, &awstypes.EvaluationFormItemMemberQuestion{Value: question})
+	}
+
+	return apiObjects
+}
+
+func expandEvaluationFormNumericQuestionProperties(tfMap map[string]any) awstypes.EvaluationFormNumericQuestionProperties {
+	apiObject := awstypes.EvaluationFormNumericQuestionProperties{
+		MaxValue: int32(tfMap["max_value"].(int)),
+		MinValue: int32(tfMap["min_value"].(int)),
+	}
+
+	if v, ok := tfMap["option"].([]any); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			apiObject.Options = append(apiObject.Options, awstypes.EvaluationFormNumericQuestionOption{
+				AutomaticFail: tfMap["automatic_fail"].(bool),
+				MaxValue:      int32(tfMap["max_value"].(int)),
+				MinValue:      int32(tfMap["min_value"].(int)),
+				Score:         int32(tfMap["score"].(int)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandEvaluationFormSingleSelectQuestionProperties(tfMap map[string]any) awstypes.EvaluationFormSingleSelectQuestionProperties {
+	apiObject := awstypes.EvaluationFormSingleSelectQuestionProperties{}
+
+	if v, ok := tfMap["display_as"].(string); ok && v != "" {
+		apiObject.DisplayAs = awstypes.EvaluationFormSingleSelectQuestionDisplayMode(v)
+	}
+
+	for _, tfMapRaw := range tfMap["option"].([]any) {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject.Options = append(apiObject.Options, awstypes.EvaluationFormSingleSelectQuestionOption{
+			AutomaticFail: tfMap["automatic_fail"].(bool),
+			RefId:         aws.String(tfMap["ref_id"].(string)),
+			Score:         int32(tfMap["score"].(int)),
+			Text:          aws.String(tfMap["text"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenEvaluationFormSections(apiObjects []awstypes.EvaluationFormItem) []any {
+	tfList := []any{}
+
+	for _, apiObject := range apiObjects {
+		v, ok := apiObject.(*awstypes.EvaluationFormItemMemberSection)
+		if !ok {
+			continue
+		}
+
+		section := v.Value
+		tfMap := map[string]any{
+			"instructions":   aws.ToString(section.Instructions),
+			"question":       flattenEvaluationFormQuestions(section.Items),
+			"ref_id":         aws.ToString(section.RefId),
+			"title":          aws.ToString(section.Title),
+			names.AttrWeight: section.Weight,
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEvaluationFormQuestions(apiObjects []awstypes.EvaluationFormItem) []any {
+	tfList := []any{}
+
+	for _, apiObject := range apiObjects {
+		v, ok := apiObject.(*awstypes.EvaluationFormItemMemberQuestion)
+		if !ok {
+			continue
+		}
+
+		question := v.Value
+		tfMap := map[string]any{
+			"instructions":           aws.ToString(question.Instructions),
+			"not_applicable_enabled": question.NotApplicableEnabled,
+			"question_type":          question.QuestionType,
+			"ref_id":                 aws.ToString(question.RefId),
+			"title":                  aws.ToString(question.Title),
+			names.AttrWeight:         question.Weight,
+		}
+
+		switch v := question.QuestionTypeProperties.(type) {
+		case *awstypes.EvaluationFormQuestionTypePropertiesMemberNumeric:
+			tfMap["numeric_properties"] = flattenEvaluationFormNumericQuestionProperties(v.Value)
+		case *awstypes.EvaluationFormQuestionTypePropertiesMemberSingleSelect:
+			tfMap["single_select_properties"] = flattenEvaluationFormSingleSelectQuestionProperties(v.Value)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenEvaluationFormNumericQuestionProperties(apiObject awstypes.EvaluationFormNumericQuestionProperties) []any {
+	options := []any{}
+
+	for _, v := range apiObject.Options {
+		options = append(options, map[string]any{
+			"automatic_fail": v.AutomaticFail,
+			"max_value":      v.MaxValue,
+			"min_value":      v.MinValue,
+			"score":          v.Score,
+		})
+	}
+
+	tfMap := map[string]any{
+		"max_value": apiObject.MaxValue,
+		"min_value": apiObject.MinValue,
+		"option":    options,
+	}
+
+	return []any{tfMap}
+}
+
+func flattenEvaluationFormSingleSelectQuestionProperties(apiObject awstypes.EvaluationFormSingleSelectQuestionProperties) []any {
+	options := []any{}
+
+	for _, v := range apiObject.Options {
+		options = append(options, map[string]any{
+			"automatic_fail": v.AutomaticFail,
+			"ref_id":         aws.ToString(v.RefId),
+			"score":          v.Score,
+			"text":           aws.ToString(v.Text),
+		})
+	}
+
+	tfMap := map[string]any{
+		"display_as": apiObject.DisplayAs,
+		"option":     options,
+	}
+
+	return []any{tfMap}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_connect_evaluation_form", name="Evaluation Form")
+func dataSourceEvaluationForm() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEvaluationFormRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"evaluation_form_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"evaluation_form_id", "title"},
+			},
+			"evaluation_form_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"scoring_strategy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMode: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"section": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instructions": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"question": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instructions": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"not_applicable_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"numeric_properties": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max_value": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"min_value": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+												"option": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"automatic_fail": {
+																Type:     schema.TypeBool,
+																Computed: true,
+															},
+															"max_value": {
+																Type:     schema.TypeInt,
+																Computed: true,
+															},
+															"min_value": {
+																Type:     schema.TypeInt,
+																Computed: true,
+															},
+															"score": {
+																Type:     schema.TypeInt,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"question_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ref_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"single_select_properties": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"display_as": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"option": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"automatic_fail": {
+																Type:     schema.TypeBool,
+																Computed: true,
+															},
+															"ref_id": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+															"score": {
+																Type:     schema.TypeInt,
+																Computed: true,
+															},
+															"text": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrWeight: {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"ref_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrWeight: {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"title", "evaluation_form_id"},
+			},
+		},
+	}
+}
+
+func dataSourceEvaluationFormRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	input := &connect.DescribeEvaluationFormInput{
+		InstanceId: aws.String(instanceID),
+	}
+
+	if v, ok := d.GetOk("evaluation_form_id"); ok {
+		input.EvaluationFormId = aws.String(v.(string))
+	} else if v, ok := d.GetOk("title"); ok {
+		title := v.(string)
+		evaluationFormSummary, err := findEvaluationFormSummaryByTwoPartKey(ctx, conn, instanceID, title)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Connect Evaluation Form (%s) summary: %s", title, err)
+		}
+
+		input.EvaluationFormId = evaluationFormSummary.EvaluationFormId
+	}
+
+	evaluationForm, err := findEvaluationForm(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Evaluation Form: %s", err)
+	}
+
+	d.SetId(evaluationFormCreateResourceID(instanceID, aws.ToString(evaluationForm.EvaluationFormId)))
+	if err := resourceEvaluationFormFlatten(d, evaluationForm); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set(names.AttrInstanceID, instanceID)
+
+	return diags
+}
+
+func findEvaluationFormSummaryByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, title string) (*awstypes.EvaluationFormSummary, error) {
+	const maxResults = 100
+	input := &connect.ListEvaluationFormsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int32(maxResults),
+	}
+
+	return findEvaluationFormSummary(ctx, conn, input, func(v *awstypes.EvaluationFormSummary) bool {
+		return aws.ToString(v.Title) == title
+	})
+}
+
+func findEvaluationFormSummary(ctx context.Context, conn *connect.Client, input *connect.ListEvaluationFormsInput, filter tfslices.Predicate[*awstypes.EvaluationFormSummary]) (*awstypes.EvaluationFormSummary, error) {
+	output, err := findEvaluationFormSummaries(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findEvaluationFormSummaries(ctx context.Context, conn *connect.Client, input *connect.ListEvaluationFormsInput, filter tfslices.Predicate[*awstypes.EvaluationFormSummary]) ([]awstypes.EvaluationFormSummary, error) {
+	var output []awstypes.EvaluationFormSummary
+
+	pages := connect.NewListEvaluationFormsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.EvaluationFormSummaryList {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
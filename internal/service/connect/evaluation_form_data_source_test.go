@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEvaluationFormDataSource_evaluationFormID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_evaluation_form.test"
+	datasourceName := "data.aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormDataSourceConfig_id(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_form_id", resourceName, "evaluation_form_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_form_version", resourceName, "evaluation_form_version"),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrInstanceID, resourceName, names.AttrInstanceID),
+					resource.TestCheckResourceAttrPair(datasourceName, "section.#", resourceName, "section.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, "section.0.question.#", resourceName, "section.0.question.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrStatus, resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrPair(datasourceName, "title", resourceName, "title"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvaluationFormDataSource_title(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_evaluation_form.test"
+	datasourceName := "data.aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormDataSourceConfig_title(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, "evaluation_form_id", resourceName, "evaluation_form_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrInstanceID, resourceName, names.AttrInstanceID),
+					resource.TestCheckResourceAttrPair(datasourceName, "title", resourceName, "title"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEvaluationFormDataSourceConfig_id(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccEvaluationFormConfig_basic(rName, rName2), `
+data "aws_connect_evaluation_form" "test" {
+  instance_id        = aws_connect_instance.test.id
+  evaluation_form_id = aws_connect_evaluation_form.test.evaluation_form_id
+}
+`)
+}
+
+func testAccEvaluationFormDataSourceConfig_title(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccEvaluationFormConfig_basic(rName, rName2), `
+data "aws_connect_evaluation_form" "test" {
+  instance_id = aws_connect_instance.test.id
+  title       = aws_connect_evaluation_form.test.title
+}
+`)
+}
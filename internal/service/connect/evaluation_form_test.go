@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEvaluationForm_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.EvaluationForm
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationFormDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test Evaluation Form"),
+					resource.TestCheckResourceAttrSet(resourceName, "evaluation_form_id"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_form_version", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrInstanceID, "aws_connect_instance.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "section.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.ref_id", "section1"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.title", "Greeting"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.question.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.question.0.question_type", "SINGLESELECT"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.question.0.single_select_properties.0.option.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.question.1.question_type", "TEXT"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "title", rName2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEvaluationForm_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.EvaluationForm
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationFormDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourceEvaluationForm(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccEvaluationForm_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.EvaluationForm
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_evaluation_form.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationFormDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationFormConfig_basic(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_form_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scoring_strategy.#", "0"),
+				),
+			},
+			{
+				Config: testAccEvaluationFormConfig_updated(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEvaluationFormExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated Evaluation Form"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_form_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scoring_strategy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scoring_strategy.0.mode", "QUESTION_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "scoring_strategy.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.question.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.question.0.question_type", "NUMERIC"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.question.0.numeric_properties.0.max_value", "10"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEvaluationFormExists(ctx context.Context, n string, v *awstypes.EvaluationForm) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient(ctx)
+
+		output, err := tfconnect.FindEvaluationFormByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrInstanceID], rs.Primary.Attributes["evaluation_form_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEvaluationFormDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_evaluation_form" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient(ctx)
+
+			_, err := tfconnect.FindEvaluationFormByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrInstanceID], rs.Primary.Attributes["evaluation_form_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Evaluation Form %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEvaluationFormConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccAgentStatusConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_evaluation_form" "test" {
+  instance_id = aws_connect_instance.test.id
+  title       = %[1]q
+  description = "Test Evaluation Form"
+
+  section {
+    ref_id = "section1"
+    title  = "Greeting"
+
+    question {
+      ref_id        = "question1"
+      title         = "Did the agent greet the customer?"
+      question_type = "SINGLESELECT"
+      weight        = 50
+
+      single_select_properties {
+        option {
+          ref_id = "yes"
+          text   = "Yes"
+        }
+
+        option {
+          ref_id = "no"
+          text   = "No"
+        }
+      }
+    }
+
+    question {
+      ref_id        = "question2"
+      title         = "Notes"
+      question_type = "TEXT"
+      weight        = 50
+    }
+  }
+}
+`, rName2))
+}
+
+func testAccEvaluationFormConfig_updated(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccAgentStatusConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_evaluation_form" "test" {
+  instance_id = aws_connect_instance.test.id
+  title       = %[1]q
+  description = "Updated Evaluation Form"
+
+  scoring_strategy {
+    mode   = "QUESTION_ONLY"
+    status = "ENABLED"
+  }
+
+  section {
+    ref_id = "section1"
+    title  = "Greeting"
+
+    question {
+      ref_id        = "question1"
+      title         = "How well did the agent greet the customer?"
+      question_type = "NUMERIC"
+      weight        = 100
+
+      numeric_properties {
+        min_value = 0
+        max_value = 10
+
+        option {
+          min_value = 0
+          max_value = 5
+          score     = 0
+        }
+
+        option {
+          min_value = 6
+          max_value = 10
+          score     = 10
+        }
+      }
+    }
+  }
+}
+`, rName2))
+}
@@ -5,37 +5,51 @@ package connect
 
 // Exports for use in tests only.
 var (
+	ResourceAgentStatus               = resourceAgentStatus
 	ResourceBotAssociation            = resourceBotAssociation
 	ResourceContactFlow               = resourceContactFlow
 	ResourceContactFlowModule         = resourceContactFlowModule
+	ResourceEvaluationForm            = resourceEvaluationForm
 	ResourceHoursOfOperation          = resourceHoursOfOperation
 	ResourceInstance                  = resourceInstance
 	ResourceInstanceStorageConfig     = resourceInstanceStorageConfig
 	ResourceLambdaFunctionAssociation = resourceLambdaFunctionAssociation
 	ResourcePhoneNumber               = resourcePhoneNumber
+	ResourcePredefinedAttribute       = resourcePredefinedAttribute
 	ResourceQueue                     = resourceQueue
 	ResourceQuickConnect              = resourceQuickConnect
 	ResourceRoutingProfile            = resourceRoutingProfile
+	ResourceRule                      = resourceRule
 	ResourceSecurityProfile           = resourceSecurityProfile
+	ResourceTaskTemplate              = resourceTaskTemplate
 	ResourceUser                      = resourceUser
 	ResourceUserHierarchyGroup        = resourceUserHierarchyGroup
 	ResourceUserHierarchyStructure    = resourceUserHierarchyStructure
+	ResourceView                      = resourceView
+	ResourceViewVersion               = resourceViewVersion
 	ResourceVocabulary                = resourceVocabulary
 
+	FindAgentStatusByTwoPartKey               = findAgentStatusByTwoPartKey
 	FindBotAssociationByThreePartKey          = findBotAssociationByThreePartKey
 	FindContactFlowByTwoPartKey               = findContactFlowByTwoPartKey
 	FindContactFlowModuleByTwoPartKey         = findContactFlowModuleByTwoPartKey
+	FindEvaluationFormByTwoPartKey            = findEvaluationFormByTwoPartKey
 	FindHoursOfOperationByTwoPartKey          = findHoursOfOperationByTwoPartKey
 	FindInstanceByID                          = findInstanceByID
 	FindInstanceStorageConfigByThreePartKey   = findInstanceStorageConfigByThreePartKey
 	FindLambdaFunctionAssociationByTwoPartKey = findLambdaFunctionAssociationByTwoPartKey
 	FindPhoneNumberByID                       = findPhoneNumberByID
+	FindPredefinedAttributeByTwoPartKey       = findPredefinedAttributeByTwoPartKey
 	FindQueueByTwoPartKey                     = findQueueByTwoPartKey
 	FindQuickConnectByTwoPartKey              = findQuickConnectByTwoPartKey
 	FindRoutingProfileByTwoPartKey            = findRoutingProfileByTwoPartKey
+	FindRuleByTwoPartKey                      = findRuleByTwoPartKey
 	FindSecurityProfileByTwoPartKey           = findSecurityProfileByTwoPartKey
+	FindTaskTemplateByTwoPartKey              = findTaskTemplateByTwoPartKey
 	FindUserByTwoPartKey                      = findUserByTwoPartKey
 	FindUserHierarchyGroupByTwoPartKey        = findUserHierarchyGroupByTwoPartKey
 	FindUserHierarchyStructureByID            = findUserHierarchyStructureByID
+	FindViewByTwoPartKey                      = findViewByTwoPartKey
+	FindViewVersionByThreePartKey             = findViewVersionByThreePartKey
 	FindVocabularyByTwoPartKey                = findVocabularyByTwoPartKey
 )
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_predefined_attribute", name="Predefined Attribute")
+func resourcePredefinedAttribute() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePredefinedAttributeCreate,
+		ReadWithoutTimeout:   resourcePredefinedAttributeRead,
+		UpdateWithoutTimeout: resourcePredefinedAttributeUpdate,
+		DeleteWithoutTimeout: resourcePredefinedAttributeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"last_modified_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			names.AttrValues: {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 128,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+		},
+	}
+}
+
+func resourcePredefinedAttributeCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	name := d.Get(names.AttrName).(string)
+	input := &connect.CreatePredefinedAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+		Values: &awstypes.PredefinedAttributeValuesMemberStringList{
+			Value: flex.ExpandStringValueSet(d.Get(names.AttrValues).(*schema.Set)),
+		},
+	}
+
+	_, err := conn.CreatePredefinedAttribute(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Predefined Attribute (%s): %s", name, err)
+	}
+
+	id := predefinedAttributeCreateResourceID(instanceID, name)
+	d.SetId(id)
+
+	return append(diags, resourcePredefinedAttributeRead(ctx, d, meta)...)
+}
+
+func resourcePredefinedAttributeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, name, err := predefinedAttributeParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	predefinedAttribute, err := findPredefinedAttributeByTwoPartKey(ctx, conn, instanceID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Predefined Attribute (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Predefined Attribute (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrInstanceID, instanceID)
+	d.Set("last_modified_region", predefinedAttribute.LastModifiedRegion)
+	if predefinedAttribute.LastModifiedTime != nil {
+		d.Set("last_modified_time", predefinedAttribute.LastModifiedTime.Format(time.RFC3339))
+	}
+	d.Set(names.AttrName, predefinedAttribute.Name)
+	d.Set(names.AttrValues, flattenPredefinedAttributeValues(predefinedAttribute.Values))
+
+	return diags
+}
+
+func resourcePredefinedAttributeUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, name, err := predefinedAttributeParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange(names.AttrValues) {
+		input := &connect.UpdatePredefinedAttributeInput{
+			InstanceId: aws.String(instanceID),
+			Name:       aws.String(name),
+			Values: &awstypes.PredefinedAttributeValuesMemberStringList{
+				Value: flex.ExpandStringValueSet(d.Get(names.AttrValues).(*schema.Set)),
+			},
+		}
+
+		_, err = conn.UpdatePredefinedAttribute(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect Predefined Attribute (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePredefinedAttributeRead(ctx, d, meta)...)
+}
+
+func resourcePredefinedAttributeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, name, err := predefinedAttributeParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Predefined Attribute: %s", d.Id())
+	input := connect.DeletePredefinedAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+	}
+	_, err = conn.DeletePredefinedAttribute(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect Predefined Attribute (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const predefinedAttributeResourceIDSeparator = ":"
+
+func predefinedAttributeCreateResourceID(instanceID, name string) string {
+	parts := []string{instanceID, name}
+	id := strings.Join(parts, predefinedAttributeResourceIDSeparator)
+
+	return id
+}
+
+func predefinedAttributeParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, predefinedAttributeResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected instanceID%[2]sname", id, predefinedAttributeResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findPredefinedAttributeByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, name string) (*awstypes.PredefinedAttribute, error) {
+	input := &connect.DescribePredefinedAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Name:       aws.String(name),
+	}
+
+	return findPredefinedAttribute(ctx, conn, input)
+}
+
+func findPredefinedAttribute(ctx context.Context, conn *connect.Client, input *connect.DescribePredefinedAttributeInput) (*awstypes.PredefinedAttribute, error) {
+	output, err := conn.DescribePredefinedAttribute(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PredefinedAttribute == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PredefinedAttribute, nil
+}
+
+func flattenPredefinedAttributeValues(apiObject awstypes.PredefinedAttributeValues) []string {
+	switch v := apiObject.(type) {
+	case *awstypes.PredefinedAttributeValuesMemberStringList:
+		return v.Value
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_connect_predefined_attribute", name="Predefined Attribute")
+func dataSourcePredefinedAttribute() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePredefinedAttributeRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"last_modified_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrValues: {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePredefinedAttributeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	name := d.Get(names.AttrName).(string)
+
+	predefinedAttribute, err := findPredefinedAttributeByTwoPartKey(ctx, conn, instanceID, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Predefined Attribute (%s): %s", name, err)
+	}
+
+	d.SetId(predefinedAttributeCreateResourceID(instanceID, name))
+	d.Set(names.AttrInstanceID, instanceID)
+	d.Set("last_modified_region", predefinedAttribute.LastModifiedRegion)
+	if predefinedAttribute.LastModifiedTime != nil {
+		d.Set("last_modified_time", predefinedAttribute.LastModifiedTime.Format(time.RFC3339))
+	}
+	d.Set(names.AttrName, predefinedAttribute.Name)
+	d.Set(names.AttrValues, flattenPredefinedAttributeValues(predefinedAttribute.Values))
+
+	return diags
+}
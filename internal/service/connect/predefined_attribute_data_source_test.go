@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPredefinedAttributeDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("tf")
+	resourceName := "aws_connect_predefined_attribute.test"
+	datasourceName := "data.aws_connect_predefined_attribute.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredefinedAttributeDataSourceConfig_name(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrInstanceID, resourceName, names.AttrInstanceID),
+					resource.TestCheckResourceAttrPair(datasourceName, "last_modified_time", resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(datasourceName, "values.#", resourceName, "values.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPredefinedAttributeDataSourceConfig_name(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccPredefinedAttributeConfig_basic(rName, rName2, `"English", "Spanish"`), `
+data "aws_connect_predefined_attribute" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = aws_connect_predefined_attribute.test.name
+}
+`)
+}
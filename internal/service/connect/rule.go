@@ -0,0 +1,548 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_rule", name="Rule")
+func resourceRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuleCreate,
+		ReadWithoutTimeout:   resourceRuleRead,
+		UpdateWithoutTimeout: resourceRuleUpdate,
+		DeleteWithoutTimeout: resourceRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAction: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ActionType](),
+						},
+						"event_bridge_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+						"send_notification_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrContent: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									names.AttrContentType: {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.NotificationContentType](),
+									},
+									"delivery_method": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.NotificationDeliveryType](),
+									},
+									"recipient": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"user_ids": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"user_tags": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"subject": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 200),
+									},
+								},
+							},
+						},
+						"task_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"contact_flow_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 500),
+									},
+									names.AttrDescription: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 4096),
+									},
+									names.AttrName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"last_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+			"publish_status": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RulePublishStatus](),
+			},
+			"rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trigger_event_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_source_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.EventSourceName](),
+						},
+						"integration_association_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 200),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRuleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	name := d.Get(names.AttrName).(string)
+	input := &connect.CreateRuleInput{
+		Actions:       expandRuleActions(d.Get(names.AttrAction).([]any)),
+		ClientToken:   aws.String(id.UniqueId()),
+		Function:      aws.String(d.Get("function").(string)),
+		InstanceId:    aws.String(instanceID),
+		Name:          aws.String(name),
+		PublishStatus: awstypes.RulePublishStatus(d.Get("publish_status").(string)),
+	}
+
+	if v, ok := d.GetOk("trigger_event_source"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.TriggerEventSource = expandRuleTriggerEventSource(v.([]any)[0].(map[string]any))
+	}
+
+	output, err := conn.CreateRule(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Rule (%s): %s", name, err)
+	}
+
+	id := ruleCreateResourceID(instanceID, aws.ToString(output.RuleId))
+	d.SetId(id)
+
+	return append(diags, resourceRuleRead(ctx, d, meta)...)
+}
+
+func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, ruleID, err := ruleParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	rule, err := findRuleByTwoPartKey(ctx, conn, instanceID, ruleID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := resourceRuleFlatten(d, rule); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set(names.AttrInstanceID, instanceID)
+
+	return diags
+}
+
+func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, ruleID, err := ruleParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &connect.UpdateRuleInput{
+		Actions:       expandRuleActions(d.Get(names.AttrAction).([]any)),
+		Function:      aws.String(d.Get("function").(string)),
+		InstanceId:    aws.String(instanceID),
+		Name:          aws.String(d.Get(names.AttrName).(string)),
+		PublishStatus: awstypes.RulePublishStatus(d.Get("publish_status").(string)),
+		RuleId:        aws.String(ruleID),
+	}
+
+	_, err = conn.UpdateRule(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Connect Rule (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRuleRead(ctx, d, meta)...)
+}
+
+func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, ruleID, err := ruleParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Rule: %s", d.Id())
+	input := connect.DeleteRuleInput{
+		InstanceId: aws.String(instanceID),
+		RuleId:     aws.String(ruleID),
+	}
+	_, err = conn.DeleteRule(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceRuleFlatten(d *schema.ResourceData, rule *awstypes.Rule) error {
+	if err := d.Set(names.AttrAction, flattenRuleActions(rule.Actions)); err != nil {
+		return fmt.Errorf("setting action: %w", err)
+	}
+	d.Set(names.AttrARN, rule.RuleArn)
+	if rule.CreatedTime != nil {
+		d.Set(names.AttrCreatedTime, rule.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("function", rule.Function)
+	d.Set("last_updated_by", rule.LastUpdatedBy)
+	if rule.LastUpdatedTime != nil {
+		d.Set("last_updated_time", rule.LastUpdatedTime.Format(time.RFC3339))
+	}
+	d.Set(names.AttrName, rule.Name)
+	d.Set("publish_status", rule.PublishStatus)
+	d.Set("rule_id", rule.RuleId)
+	if err := d.Set("trigger_event_source", flattenRuleTriggerEventSource(rule.TriggerEventSource)); err != nil {
+		return fmt.Errorf("setting trigger_event_source: %w", err)
+	}
+
+	return nil
+}
+
+const ruleResourceIDSeparator = ":"
+
+func ruleCreateResourceID(instanceID, ruleID string) string {
+	parts := []string{instanceID, ruleID}
+	id := strings.Join(parts, ruleResourceIDSeparator)
+
+	return id
+}
+
+func ruleParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ruleResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected instanceID%[2]sruleID", id, ruleResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findRuleByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, ruleID string) (*awstypes.Rule, error) {
+	input := &connect.DescribeRuleInput{
+		InstanceId: aws.String(instanceID),
+		RuleId:     aws.String(ruleID),
+	}
+
+	return findRule(ctx, conn, input)
+}
+
+func findRule(ctx context.Context, conn *connect.Client, input *connect.DescribeRuleInput) (*awstypes.Rule, error) {
+	output, err := conn.DescribeRule(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Rule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Rule, nil
+}
+
+func expandRuleTriggerEventSource(tfMap map[string]any) *awstypes.RuleTriggerEventSource {
+	apiObject := &awstypes.RuleTriggerEventSource{
+		EventSourceName: awstypes.EventSourceName(tfMap["event_source_name"].(string)),
+	}
+
+	if v, ok := tfMap["integration_association_id"].(string); ok && v != "" {
+		apiObject.IntegrationAssociationId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRuleTriggerEventSource(apiObject *awstypes.RuleTriggerEventSource) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"event_source_name":          apiObject.EventSourceName,
+		"integration_association_id": aws.ToString(apiObject.IntegrationAssociationId),
+	}
+
+	return []any{tfMap}
+}
+
+func expandRuleActions(tfList []any) []awstypes.RuleAction {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := []awstypes.RuleAction{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		actionType := awstypes.ActionType(tfMap["action_type"].(string))
+		apiObject := awstypes.RuleAction{
+			ActionType: actionType,
+		}
+
+		// The contact category action has no configurable properties.
+		if actionType == awstypes.ActionTypeAssignContactCategory {
+			apiObject.AssignContactCategoryAction = &awstypes.AssignContactCategoryActionDefinition{}
+		}
+
+		if v, ok := tfMap["event_bridge_action"].([]any); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]any)
+
+			apiObject.EventBridgeAction = &awstypes.EventBridgeActionDefinition{
+				Name: aws.String(tfMap[names.AttrName].(string)),
+			}
+		}
+
+		if v, ok := tfMap["send_notification_action"].([]any); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SendNotificationAction = expandSendNotificationActionDefinition(v[0].(map[string]any))
+		}
+
+		if v, ok := tfMap["task_action"].([]any); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]any)
+
+			apiObject.TaskAction = &awstypes.TaskActionDefinition{
+				ContactFlowId: aws.String(tfMap["contact_flow_id"].(string)),
+				Name:          aws.String(tfMap[names.AttrName].(string)),
+			}
+
+			if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+				apiObject.TaskAction.Description = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSendNotificationActionDefinition(tfMap map[string]any) *awstypes.SendNotificationActionDefinition {
+	apiObject := &awstypes.SendNotificationActionDefinition{
+		Content:        aws.String(tfMap[names.AttrContent].(string)),
+		ContentType:    awstypes.NotificationContentType(tfMap[names.AttrContentType].(string)),
+		DeliveryMethod: awstypes.NotificationDeliveryType(tfMap["delivery_method"].(string)),
+	}
+
+	if v, ok := tfMap["recipient"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		recipient := &awstypes.NotificationRecipientType{}
+
+		if v, ok := tfMap["user_ids"].(*schema.Set); ok && v.Len() > 0 {
+			recipient.UserIds = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["user_tags"].(map[string]any); ok && len(v) > 0 {
+			recipient.UserTags = flex.ExpandStringValueMap(v)
+		}
+
+		apiObject.Recipient = recipient
+	}
+
+	if v, ok := tfMap["subject"].(string); ok && v != "" {
+		apiObject.Subject = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRuleActions(apiObjects []awstypes.RuleAction) []any {
+	tfList := []any{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"action_type": apiObject.ActionType,
+		}
+
+		if v := apiObject.EventBridgeAction; v != nil {
+			tfMap["event_bridge_action"] = []any{map[string]any{
+				names.AttrName: aws.ToString(v.Name),
+			}}
+		}
+
+		if v := apiObject.SendNotificationAction; v != nil {
+			tfMapAction := map[string]any{
+				names.AttrContent:     aws.ToString(v.Content),
+				names.AttrContentType: v.ContentType,
+				"delivery_method":     v.DeliveryMethod,
+				"subject":             aws.ToString(v.Subject),
+			}
+
+			if v := v.Recipient; v != nil {
+				tfMapAction["recipient"] = []any{map[string]any{
+					"user_ids":  v.UserIds,
+					"user_tags": v.UserTags,
+				}}
+			}
+
+			tfMap["send_notification_action"] = []any{tfMapAction}
+		}
+
+		if v := apiObject.TaskAction; v != nil {
+			tfMap["task_action"] = []any{map[string]any{
+				"contact_flow_id":     aws.ToString(v.ContactFlowId),
+				names.AttrDescription: aws.ToString(v.Description),
+				names.AttrName:        aws.ToString(v.Name),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
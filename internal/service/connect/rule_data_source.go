@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_connect_rule", name="Rule")
+func dataSourceRule() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAction: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_bridge_action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"send_notification_action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrContent: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrContentType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"delivery_method": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"recipient": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"user_ids": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"user_tags": {
+													Type:     schema.TypeMap,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"subject": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"task_action": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"contact_flow_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrDescription: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"last_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrName, "rule_id"},
+			},
+			"publish_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"rule_id", names.AttrName},
+			},
+			"trigger_event_source": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_source_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRuleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	input := &connect.DescribeRuleInput{
+		InstanceId: aws.String(instanceID),
+	}
+
+	if v, ok := d.GetOk("rule_id"); ok {
+		input.RuleId = aws.String(v.(string))
+	} else if v, ok := d.GetOk(names.AttrName); ok {
+		name := v.(string)
+		ruleSummary, err := findRuleSummaryByTwoPartKey(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Connect Rule (%s) summary: %s", name, err)
+		}
+
+		input.RuleId = ruleSummary.RuleId
+	}
+
+	rule, err := findRule(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Rule: %s", err)
+	}
+
+	d.SetId(ruleCreateResourceID(instanceID, aws.ToString(rule.RuleId)))
+	if err := resourceRuleFlatten(d, rule); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set(names.AttrInstanceID, instanceID)
+
+	return diags
+}
+
+func findRuleSummaryByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, name string) (*awstypes.RuleSummary, error) {
+	const maxResults = 200
+	input := &connect.ListRulesInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int32(maxResults),
+	}
+
+	return findRuleSummary(ctx, conn, input, func(v *awstypes.RuleSummary) bool {
+		return aws.ToString(v.Name) == name
+	})
+}
+
+func findRuleSummary(ctx context.Context, conn *connect.Client, input *connect.ListRulesInput, filter tfslices.Predicate[*awstypes.RuleSummary]) (*awstypes.RuleSummary, error) {
+	output, err := findRuleSummaries(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRuleSummaries(ctx context.Context, conn *connect.Client, input *connect.ListRulesInput, filter tfslices.Predicate[*awstypes.RuleSummary]) ([]awstypes.RuleSummary, error) {
+	var output []awstypes.RuleSummary
+
+	pages := connect.NewListRulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RuleSummaryList {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRuleDataSource_ruleID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_rule.test"
+	datasourceName := "data.aws_connect_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleDataSourceConfig_id(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "action.#", resourceName, "action.#"),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, "function", resourceName, "function"),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrInstanceID, resourceName, names.AttrInstanceID),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(datasourceName, "publish_status", resourceName, "publish_status"),
+					resource.TestCheckResourceAttrPair(datasourceName, "rule_id", resourceName, "rule_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "trigger_event_source.#", resourceName, "trigger_event_source.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuleDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_rule.test"
+	datasourceName := "data.aws_connect_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleDataSourceConfig_name(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrInstanceID, resourceName, names.AttrInstanceID),
+					resource.TestCheckResourceAttrPair(datasourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(datasourceName, "rule_id", resourceName, "rule_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuleDataSourceConfig_id(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_basic(rName, rName2, "PUBLISHED"), `
+data "aws_connect_rule" "test" {
+  instance_id = aws_connect_instance.test.id
+  rule_id     = aws_connect_rule.test.rule_id
+}
+`)
+}
+
+func testAccRuleDataSourceConfig_name(rName, rName2 string) string {
+	return acctest.ConfigCompose(testAccRuleConfig_basic(rName, rName2, "PUBLISHED"), `
+data "aws_connect_rule" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = aws_connect_rule.test.name
+}
+`)
+}
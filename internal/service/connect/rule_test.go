@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Rule
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_basic(rName, rName2, "PUBLISHED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.action_type", "GENERATE_EVENTBRIDGE_EVENT"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.event_bridge_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.event_bridge_action.0.name", rName2),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "function"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrInstanceID, "aws_connect_instance.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+					resource.TestCheckResourceAttr(resourceName, "publish_status", "PUBLISHED"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_event_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_event_source.0.event_source_name", "OnPostCallAnalysisAvailable"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleConfig_basic(rName, rName2, "DRAFT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "publish_status", "DRAFT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Rule
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_basic(rName, rName2, "PUBLISHED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourceRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRule_taskAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Rule
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleConfig_taskAction(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.action_type", "CREATE_TASK"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.task_action.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "action.0.task_action.0.contact_flow_id", "data.aws_connect_contact_flow.test", "contact_flow_id"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.task_action.0.name", "Follow up"),
+					resource.TestCheckResourceAttr(resourceName, "action.1.action_type", "ASSIGN_CONTACT_CATEGORY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuleExists(ctx context.Context, n string, v *awstypes.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient(ctx)
+
+		output, err := tfconnect.FindRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrInstanceID], rs.Primary.Attributes["rule_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_rule" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient(ctx)
+
+			_, err := tfconnect.FindRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrInstanceID], rs.Primary.Attributes["rule_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccRuleConfig_basic(rName, rName2, publishStatus string) string {
+	return acctest.ConfigCompose(
+		testAccAgentStatusConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_rule" "test" {
+  instance_id    = aws_connect_instance.test.id
+  name           = %[1]q
+  function       = "$.ContactLens.PostCall.Keywords.Exact.includes([\"refund\"])"
+  publish_status = %[2]q
+
+  trigger_event_source {
+    event_source_name = "OnPostCallAnalysisAvailable"
+  }
+
+  action {
+    action_type = "GENERATE_EVENTBRIDGE_EVENT"
+
+    event_bridge_action {
+      name = %[1]q
+    }
+  }
+}
+`, rName2, publishStatus))
+}
+
+func testAccRuleConfig_taskAction(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccAgentStatusConfig_base(rName),
+		fmt.Sprintf(`
+data "aws_connect_contact_flow" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = "Default agent transfer"
+}
+
+resource "aws_connect_rule" "test" {
+  instance_id    = aws_connect_instance.test.id
+  name           = %[1]q
+  function       = "$.ContactLens.PostCall.Keywords.Exact.includes([\"refund\"])"
+  publish_status = "PUBLISHED"
+
+  trigger_event_source {
+    event_source_name = "OnPostCallAnalysisAvailable"
+  }
+
+  action {
+    action_type = "CREATE_TASK"
+
+    task_action {
+      contact_flow_id = data.aws_connect_contact_flow.test.contact_flow_id
+      name            = "Follow up"
+      description     = "Follow up with the customer"
+    }
+  }
+
+  action {
+    action_type = "ASSIGN_CONTACT_CATEGORY"
+  }
+}
+`, rName2))
+}
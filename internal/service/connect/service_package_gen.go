@@ -24,6 +24,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceAgentStatus,
+			TypeName: "aws_connect_agent_status",
+			Name:     "Agent Status",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  dataSourceBotAssociation,
 			TypeName: "aws_connect_bot_association",
@@ -41,6 +47,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Contact Flow Module",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceEvaluationForm,
+			TypeName: "aws_connect_evaluation_form",
+			Name:     "Evaluation Form",
+		},
 		{
 			Factory:  dataSourceHoursOfOperation,
 			TypeName: "aws_connect_hours_of_operation",
@@ -63,6 +74,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_connect_lambda_function_association",
 			Name:     "Lambda Function Association",
 		},
+		{
+			Factory:  dataSourcePredefinedAttribute,
+			TypeName: "aws_connect_predefined_attribute",
+			Name:     "Predefined Attribute",
+		},
 		{
 			Factory:  dataSourcePrompt,
 			TypeName: "aws_connect_prompt",
@@ -86,12 +102,22 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Routing Profile",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceRule,
+			TypeName: "aws_connect_rule",
+			Name:     "Rule",
+		},
 		{
 			Factory:  dataSourceSecurityProfile,
 			TypeName: "aws_connect_security_profile",
 			Name:     "Security Profile",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceTaskTemplate,
+			TypeName: "aws_connect_task_template",
+			Name:     "Task Template",
+		},
 		{
 			Factory:  DataSourceUser,
 			TypeName: "aws_connect_user",
@@ -109,6 +135,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_connect_user_hierarchy_structure",
 			Name:     "User Hierarchy Structure",
 		},
+		{
+			Factory:  dataSourceView,
+			TypeName: "aws_connect_view",
+			Name:     "View",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  dataSourceVocabulary,
 			TypeName: "aws_connect_vocabulary",
@@ -120,6 +152,14 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceAgentStatus,
+			TypeName: "aws_connect_agent_status",
+			Name:     "Agent Status",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceBotAssociation,
 			TypeName: "aws_connect_bot_association",
@@ -141,6 +181,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceEvaluationForm,
+			TypeName: "aws_connect_evaluation_form",
+			Name:     "Evaluation Form",
+		},
 		{
 			Factory:  resourceHoursOfOperation,
 			TypeName: "aws_connect_hours_of_operation",
@@ -175,6 +220,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourcePredefinedAttribute,
+			TypeName: "aws_connect_predefined_attribute",
+			Name:     "Predefined Attribute",
+		},
 		{
 			Factory:  resourceQueue,
 			TypeName: "aws_connect_queue",
@@ -199,6 +249,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceRule,
+			TypeName: "aws_connect_rule",
+			Name:     "Rule",
+		},
 		{
 			Factory:  resourceSecurityProfile,
 			TypeName: "aws_connect_security_profile",
@@ -207,6 +262,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceTaskTemplate,
+			TypeName: "aws_connect_task_template",
+			Name:     "Task Template",
+		},
 		{
 			Factory:  resourceUser,
 			TypeName: "aws_connect_user",
@@ -228,6 +288,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_connect_user_hierarchy_structure",
 			Name:     "User Hierarchy Structure",
 		},
+		{
+			Factory:  resourceView,
+			TypeName: "aws_connect_view",
+			Name:     "View",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceViewVersion,
+			TypeName: "aws_connect_view_version",
+			Name:     "View Version",
+		},
 		{
 			Factory:  resourceVocabulary,
 			TypeName: "aws_connect_vocabulary",
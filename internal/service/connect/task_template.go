@@ -0,0 +1,557 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_task_template", name="Task Template")
+func resourceTaskTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTaskTemplateCreate,
+		ReadWithoutTimeout:   resourceTaskTemplateRead,
+		UpdateWithoutTimeout: resourceTaskTemplateUpdate,
+		DeleteWithoutTimeout: resourceTaskTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"constraints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"invisible_fields": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"read_only_fields": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"required_fields": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"contact_flow_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"defaults": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_field_value": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDefaultValue: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(0, 4096),
+									},
+									names.AttrName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrField: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"single_select_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.TaskTemplateFieldType](),
+						},
+					},
+				},
+			},
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.TaskTemplateStatus](),
+			},
+			"task_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTaskTemplateCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	name := d.Get(names.AttrName).(string)
+	input := &connect.CreateTaskTemplateInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Fields:      expandTaskTemplateFields(d.Get(names.AttrField).([]any)),
+		InstanceId:  aws.String(instanceID),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("constraints"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.Constraints = expandTaskTemplateConstraints(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("contact_flow_id"); ok {
+		input.ContactFlowId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("defaults"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.Defaults = expandTaskTemplateDefaults(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = awstypes.TaskTemplateStatus(v.(string))
+	}
+
+	output, err := conn.CreateTaskTemplate(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Task Template (%s): %s", name, err)
+	}
+
+	id := taskTemplateCreateResourceID(instanceID, aws.ToString(output.Id))
+	d.SetId(id)
+
+	return append(diags, resourceTaskTemplateRead(ctx, d, meta)...)
+}
+
+func resourceTaskTemplateRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, taskTemplateID, err := taskTemplateParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	taskTemplate, err := findTaskTemplateByTwoPartKey(ctx, conn, instanceID, taskTemplateID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Task Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Task Template (%s): %s", d.Id(), err)
+	}
+
+	if err := resourceTaskTemplateFlatten(d, taskTemplate); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set(names.AttrInstanceID, instanceID)
+
+	return diags
+}
+
+func resourceTaskTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, taskTemplateID, err := taskTemplateParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &connect.UpdateTaskTemplateInput{
+		Constraints:    &awstypes.TaskTemplateConstraints{},
+		Defaults:       &awstypes.TaskTemplateDefaults{},
+		Description:    aws.String(d.Get(names.AttrDescription).(string)),
+		Fields:         expandTaskTemplateFields(d.Get(names.AttrField).([]any)),
+		InstanceId:     aws.String(instanceID),
+		Name:           aws.String(d.Get(names.AttrName).(string)),
+		TaskTemplateId: aws.String(taskTemplateID),
+	}
+
+	if v, ok := d.GetOk("constraints"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.Constraints = expandTaskTemplateConstraints(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("contact_flow_id"); ok {
+		input.ContactFlowId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("defaults"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.Defaults = expandTaskTemplateDefaults(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = awstypes.TaskTemplateStatus(v.(string))
+	}
+
+	_, err = conn.UpdateTaskTemplate(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Connect Task Template (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTaskTemplateRead(ctx, d, meta)...)
+}
+
+func resourceTaskTemplateDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, taskTemplateID, err := taskTemplateParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect Task Template: %s", d.Id())
+	input := connect.DeleteTaskTemplateInput{
+		InstanceId:     aws.String(instanceID),
+		TaskTemplateId: aws.String(taskTemplateID),
+	}
+	_, err = conn.DeleteTaskTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect Task Template (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTaskTemplateFlatten(d *schema.ResourceData, taskTemplate *connect.GetTaskTemplateOutput) error {
+	d.Set(names.AttrARN, taskTemplate.Arn)
+	if err := d.Set("constraints", flattenTaskTemplateConstraints(taskTemplate.Constraints)); err != nil {
+		return fmt.Errorf("setting constraints: %w", err)
+	}
+	d.Set("contact_flow_id", taskTemplate.ContactFlowId)
+	if taskTemplate.CreatedTime != nil {
+		d.Set(names.AttrCreatedTime, taskTemplate.CreatedTime.Format(time.RFC3339))
+	}
+	if err := d.Set("defaults", flattenTaskTemplateDefaults(taskTemplate.Defaults)); err != nil {
+		return fmt.Errorf("setting defaults: %w", err)
+	}
+	d.Set(names.AttrDescription, taskTemplate.Description)
+	if err := d.Set(names.AttrField, flattenTaskTemplateFields(taskTemplate.Fields)); err != nil {
+		return fmt.Errorf("setting field: %w", err)
+	}
+	if taskTemplate.LastModifiedTime != nil {
+		d.Set("last_modified_time", taskTemplate.LastModifiedTime.Format(time.RFC3339))
+	}
+	d.Set(names.AttrName, taskTemplate.Name)
+	d.Set(names.AttrStatus, taskTemplate.Status)
+	d.Set("task_template_id", taskTemplate.Id)
+
+	return nil
+}
+
+const taskTemplateResourceIDSeparator = ":"
+
+func taskTemplateCreateResourceID(instanceID, taskTemplateID string) string {
+	parts := []string{instanceID, taskTemplateID}
+	id := strings.Join(parts, taskTemplateResourceIDSeparator)
+
+	return id
+}
+
+func taskTemplateParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, taskTemplateResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected instanceID%[2]staskTemplateID", id, taskTemplateResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findTaskTemplateByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, taskTemplateID string) (*connect.GetTaskTemplateOutput, error) {
+	input := &connect.GetTaskTemplateInput{
+		InstanceId:     aws.String(instanceID),
+		TaskTemplateId: aws.String(taskTemplateID),
+	}
+
+	return findTaskTemplate(ctx, conn, input)
+}
+
+func findTaskTemplate(ctx context.Context, conn *connect.Client, input *connect.GetTaskTemplateInput) (*connect.GetTaskTemplateOutput, error) {
+	output, err := conn.GetTaskTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandTaskTemplateFields(tfList []any) []awstypes.TaskTemplateField {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := []awstypes.TaskTemplateField{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.TaskTemplateField{
+			Id: &awstypes.TaskTemplateFieldIdentifier{
+				Name: aws.String(tfMap[names.AttrName].(string)),
+			},
+			Type: awstypes.TaskTemplateFieldType(tfMap[names.AttrType].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["single_select_options"].([]any); ok && len(v) > 0 {
+			apiObject.SingleSelectOptions = flex.ExpandStringValueList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTaskTemplateFields(apiObjects []awstypes.TaskTemplateField) []any {
+	tfList := []any{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			names.AttrDescription:   aws.ToString(apiObject.Description),
+			"single_select_options": apiObject.SingleSelectOptions,
+			names.AttrType:          apiObject.Type,
+		}
+
+		if v := apiObject.Id; v != nil {
+			tfMap[names.AttrName] = aws.ToString(v.Name)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandTaskTemplateConstraints(tfMap map[string]any) *awstypes.TaskTemplateConstraints {
+	apiObject := &awstypes.TaskTemplateConstraints{}
+
+	if v, ok := tfMap["invisible_fields"].(*schema.Set); ok && v.Len() > 0 {
+		for _, name := range flex.ExpandStringValueSet(v) {
+			apiObject.InvisibleFields = append(apiObject.InvisibleFields, awstypes.InvisibleFieldInfo{
+				Id: &awstypes.TaskTemplateFieldIdentifier{Name: aws.String(name)},
+			})
+		}
+	}
+
+	if v, ok := tfMap["read_only_fields"].(*schema.Set); ok && v.Len() > 0 {
+		for _, name := range flex.ExpandStringValueSet(v) {
+			apiObject.ReadOnlyFields = append(apiObject.ReadOnlyFields, awstypes.ReadOnlyFieldInfo{
+				Id: &awstypes.TaskTemplateFieldIdentifier{Name: aws.String(name)},
+			})
+		}
+	}
+
+	if v, ok := tfMap["required_fields"].(*schema.Set); ok && v.Len() > 0 {
+		for _, name := range flex.ExpandStringValueSet(v) {
+			apiObject.RequiredFields = append(apiObject.RequiredFields, awstypes.RequiredFieldInfo{
+				Id: &awstypes.TaskTemplateFieldIdentifier{Name: aws.String(name)},
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTaskTemplateConstraints(apiObject *awstypes.TaskTemplateConstraints) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	var invisibleFields, readOnlyFields, requiredFields []string
+
+	for _, v := range apiObject.InvisibleFields {
+		if v.Id != nil {
+			invisibleFields = append(invisibleFields, aws.ToString(v.Id.Name))
+		}
+	}
+
+	for _, v := range apiObject.ReadOnlyFields {
+		if v.Id != nil {
+			readOnlyFields = append(readOnlyFields, aws.ToString(v.Id.Name))
+		}
+	}
+
+	for _, v := range apiObject.RequiredFields {
+		if v.Id != nil {
+			requiredFields = append(requiredFields, aws.ToString(v.Id.Name))
+		}
+	}
+
+	if len(invisibleFields) == 0 && len(readOnlyFields) == 0 && len(requiredFields) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"invisible_fields": invisibleFields,
+		"read_only_fields": readOnlyFields,
+		"required_fields":  requiredFields,
+	}
+
+	return []any{tfMap}
+}
+
+func expandTaskTemplateDefaults(tfMap map[string]any) *awstypes.TaskTemplateDefaults {
+	apiObject := &awstypes.TaskTemplateDefaults{}
+
+	if v, ok := tfMap["default_field_value"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			apiObject.DefaultFieldValues = append(apiObject.DefaultFieldValues, awstypes.TaskTemplateDefaultFieldValue{
+				DefaultValue: aws.String(tfMap[names.AttrDefaultValue].(string)),
+				Id: &awstypes.TaskTemplateFieldIdentifier{
+					Name: aws.String(tfMap[names.AttrName].(string)),
+				},
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTaskTemplateDefaults(apiObject *awstypes.TaskTemplateDefaults) []any {
+	if apiObject == nil || len(apiObject.DefaultFieldValues) == 0 {
+		return nil
+	}
+
+	tfList := []any{}
+
+	for _, v := range apiObject.DefaultFieldValues {
+		tfMap := map[string]any{
+			names.AttrDefaultValue: aws.ToString(v.DefaultValue),
+		}
+
+		if v.Id != nil {
+			tfMap[names.AttrName] = aws.ToString(v.Id.Name)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return []any{map[string]any{
+		"default_field_value": tfList,
+	}}
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTaskTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.GetTaskTemplateOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_task_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskTemplateConfig_basic(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "constraints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "constraints.0.required_fields.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "constraints.0.required_fields.*", "Name"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Test Task Template"),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "field.0.name", "Name"),
+					resource.TestCheckResourceAttr(resourceName, "field.0.type", "NAME"),
+					resource.TestCheckResourceAttr(resourceName, "field.1.name", "Description"),
+					resource.TestCheckResourceAttr(resourceName, "field.1.type", "DESCRIPTION"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrInstanceID, "aws_connect_instance.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_template_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTaskTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.GetTaskTemplateOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_task_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskTemplateConfig_basic(rName, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskTemplateExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourceTaskTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTaskTemplate_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v connect.GetTaskTemplateOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_task_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskTemplateConfig_basic(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "2"),
+				),
+			},
+			{
+				Config: testAccTaskTemplateConfig_updated(rName, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskTemplateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "defaults.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "defaults.0.default_field_value.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "defaults.0.default_field_value.*", map[string]string{
+						names.AttrName:         "Priority",
+						names.AttrDefaultValue: "Low",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated Task Template"),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "field.2.name", "Priority"),
+					resource.TestCheckResourceAttr(resourceName, "field.2.type", "SINGLE_SELECT"),
+					resource.TestCheckResourceAttr(resourceName, "field.2.single_select_options.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "INACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTaskTemplateExists(ctx context.Context, n string, v *connect.GetTaskTemplateOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient(ctx)
+
+		output, err := tfconnect.FindTaskTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrInstanceID], rs.Primary.Attributes["task_template_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTaskTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_task_template" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectClient(ctx)
+
+			_, err := tfconnect.FindTaskTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrInstanceID], rs.Primary.Attributes["task_template_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Connect Task Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTaskTemplateConfig_basic(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccAgentStatusConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_task_template" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = "Test Task Template"
+  status      = "ACTIVE"
+
+  field {
+    name = "Name"
+    type = "NAME"
+  }
+
+  field {
+    name = "Description"
+    type = "DESCRIPTION"
+  }
+
+  constraints {
+    required_fields = ["Name"]
+  }
+}
+`, rName2))
+}
+
+func testAccTaskTemplateConfig_updated(rName, rName2 string) string {
+	return acctest.ConfigCompose(
+		testAccAgentStatusConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_connect_task_template" "test" {
+  instance_id = aws_connect_instance.test.id
+  name        = %[1]q
+  description = "Updated Task Template"
+  status      = "INACTIVE"
+
+  field {
+    name = "Name"
+    type = "NAME"
+  }
+
+  field {
+    name = "Description"
+    type = "DESCRIPTION"
+  }
+
+  field {
+    name                  = "Priority"
+    type                  = "SINGLE_SELECT"
+    single_select_options = ["Low", "High"]
+  }
+
+  constraints {
+    required_fields = ["Name"]
+  }
+
+  defaults {
+    default_field_value {
+      name          = "Priority"
+      default_value = "Low"
+    }
+  }
+}
+`, rName2))
+}
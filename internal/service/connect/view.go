@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_view", name="View")
+// @Tags(identifierAttribute="arn")
+func resourceView() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceViewCreate,
+		ReadWithoutTimeout:   resourceViewRead,
+		UpdateWithoutTimeout: resourceViewUpdate,
+		DeleteWithoutTimeout: resourceViewDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrContent: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrActions: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+						},
+						"input_schema": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"template": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+							StateFunc: func(v any) string {
+								json, _ := structure.NormalizeJsonString(v)
+								return json
+							},
+						},
+					},
+				},
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 4096),
+			},
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ViewStatus](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"view_content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"view_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceViewCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	name := d.Get(names.AttrName).(string)
+	input := &connect.CreateViewInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Content:     expandViewInputContent(d.Get(names.AttrContent).([]any)),
+		InstanceId:  aws.String(instanceID),
+		Name:        aws.String(name),
+		Status:      awstypes.ViewStatus(d.Get(names.AttrStatus).(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateView(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect View (%s): %s", name, err)
+	}
+
+	id := viewCreateResourceID(instanceID, aws.ToString(output.View.Id))
+	d.SetId(id)
+
+	return append(diags, resourceViewRead(ctx, d, meta)...)
+}
+
+func resourceViewRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, viewID, err := viewParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	view, err := findViewByTwoPartKey(ctx, conn, instanceID, viewID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect View (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect View (%s): %s", d.Id(), err)
+	}
+
+	if err := resourceViewFlatten(d, view); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set(names.AttrInstanceID, instanceID)
+
+	setTagsOut(ctx, view.Tags)
+
+	return diags
+}
+
+func resourceViewUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, viewID, err := viewParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChanges(names.AttrDescription, names.AttrName) {
+		input := &connect.UpdateViewMetadataInput{
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			InstanceId:  aws.String(instanceID),
+			Name:        aws.String(d.Get(names.AttrName).(string)),
+			ViewId:      aws.String(viewID),
+		}
+
+		_, err := conn.UpdateViewMetadata(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect View (%s) metadata: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges(names.AttrContent, names.AttrStatus) {
+		input := &connect.UpdateViewContentInput{
+			Content:    expandViewInputContent(d.Get(names.AttrContent).([]any)),
+			InstanceId: aws.String(instanceID),
+			Status:     awstypes.ViewStatus(d.Get(names.AttrStatus).(string)),
+			ViewId:     aws.String(viewID),
+		}
+
+		_, err := conn.UpdateViewContent(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect View (%s) content: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceViewRead(ctx, d, meta)...)
+}
+
+func resourceViewDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, viewID, err := viewParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect View: %s", d.Id())
+	input := connect.DeleteViewInput{
+		InstanceId: aws.String(instanceID),
+		ViewId:     aws.String(viewID),
+	}
+	_, err = conn.DeleteView(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect View (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceViewFlatten(d *schema.ResourceData, view *awstypes.View) error {
+	d.Set(names.AttrARN, view.Arn)
+	if err := d.Set(names.AttrContent, flattenViewContent(view.Content)); err != nil {
+		return fmt.Errorf("setting content: %w", err)
+	}
+	if view.CreatedTime != nil {
+		d.Set(names.AttrCreatedTime, view.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set(names.AttrDescription, view.Description)
+	if view.LastModifiedTime != nil {
+		d.Set("last_modified_time", view.LastModifiedTime.Format(time.RFC3339))
+	}
+	d.Set(names.AttrName, view.Name)
+	d.Set(names.AttrStatus, view.Status)
+	d.Set(names.AttrType, view.Type)
+	d.Set("view_content_sha256", view.ViewContentSha256)
+	d.Set("view_id", view.Id)
+
+	return nil
+}
+
+const viewResourceIDSeparator = ":"
+
+func viewCreateResourceID(instanceID, viewID string) string {
+	parts := []string{instanceID, viewID}
+	id := strings.Join(parts, viewResourceIDSeparator)
+
+	return id
+}
+
+func viewParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, viewResourceIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected instanceID%[2]sviewID", id, viewResourceIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findViewByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, viewID string) (*awstypes.View, error) {
+	input := &connect.DescribeViewInput{
+		InstanceId: aws.String(instanceID),
+		ViewId:     aws.String(viewID),
+	}
+
+	return findView(ctx, conn, input)
+}
+
+func findView(ctx context.Context, conn *connect.Client, input *connect.DescribeViewInput) (*awstypes.View, error) {
+	output, err := conn.DescribeView(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.View == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.View, nil
+}
+
+func expandViewInputContent(tfList []any) *awstypes.ViewInputContent {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+	apiObject := &awstypes.ViewInputContent{}
+
+	if v, ok := tfMap[names.AttrActions].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Actions = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["template"].(string); ok && v != "" {
+		apiObject.Template = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenViewContent(apiObject *awstypes.ViewContent) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		names.AttrActions: apiObject.Actions,
+		"input_schema":    aws.ToString(apiObject.InputSchema),
+	}
+
+	if v := apiObject.Template; v != nil {
+		json, _ := structure.NormalizeJsonString(aws.ToString(v))
+		tfMap["template"] = json
+	}
+
+	return []any{tfMap}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_connect_view", name="View")
+// @Tags
+func dataSourceView() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceViewRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrContent: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrActions: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"input_schema": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"template": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrCreatedTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{names.AttrName, "view_id"},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"view_content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"view_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"view_id", names.AttrName},
+			},
+		},
+	}
+}
+
+func dataSourceViewRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	input := &connect.DescribeViewInput{
+		InstanceId: aws.String(instanceID),
+	}
+
+	if v, ok := d.GetOk("view_id"); ok {
+		input.ViewId = aws.String(v.(string))
+	} else if v, ok := d.GetOk(names.AttrName); ok {
+		name := v.(string)
+		viewSummary, err := findViewSummaryByTwoPartKey(ctx, conn, instanceID, name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Connect View (%s) summary: %s", name, err)
+		}
+
+		input.ViewId = viewSummary.Id
+	}
+
+	view, err := findView(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect View: %s", err)
+	}
+
+	d.SetId(viewCreateResourceID(instanceID, aws.ToString(view.Id)))
+	if err := resourceViewFlatten(d, view); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set(names.AttrInstanceID, instanceID)
+
+	setTagsOut(ctx, view.Tags)
+
+	return diags
+}
+
+func findViewSummaryByTwoPartKey(ctx context.Context, conn *connect.Client, instanceID, name string) (*awstypes.ViewSummary, error) {
+	const maxResults = 100
+	input := &connect.ListViewsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int32(maxResults),
+	}
+
+	return findViewSummary(ctx, conn, input, func(v *awstypes.ViewSummary) bool {
+		return aws.ToString(v.Name) == name
+	})
+}
+
+func findViewSummary(ctx context.Context, conn *connect.Client, input *connect.ListViewsInput, filter tfslices.Predicate[*awstypes.ViewSummary]) (*awstypes.ViewSummary, error) {
+	output, err := findViewSummaries(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findViewSummaries(ctx context.Context, conn *connect.Client, input *connect.ListViewsInput, filter tfslices.Predicate[*awstypes.ViewSummary]) ([]awstypes.ViewSummary, error) {
+	var output []awstypes.ViewSummary
+
+	pages := connect.NewListViewsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ViewsSummaryList {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_view_version", name="View Version")
+func resourceViewVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceViewVersionCreate,
+		ReadWithoutTimeout:   resourceViewVersionRead,
+		DeleteWithoutTimeout: resourceViewVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"view_content_sha256": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"view_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500),
+			},
+		},
+	}
+}
+
+func resourceViewVersionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	viewID := d.Get("view_id").(string)
+	input := &connect.CreateViewVersionInput{
+		InstanceId: aws.String(instanceID),
+		ViewId:     aws.String(viewID),
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("view_content_sha256"); ok {
+		input.ViewContentSha256 = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateViewVersion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect View (%s) version: %s", viewID, err)
+	}
+
+	id := viewVersionCreateResourceID(instanceID, viewID, output.View.Version)
+	d.SetId(id)
+
+	return append(diags, resourceViewVersionRead(ctx, d, meta)...)
+}
+
+func resourceViewVersionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, viewID, version, err := viewVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	view, err := findViewVersionByThreePartKey(ctx, conn, instanceID, viewID, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect View Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect View Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, view.Arn)
+	d.Set(names.AttrInstanceID, instanceID)
+	d.Set(names.AttrName, view.Name)
+	d.Set(names.AttrVersion, view.Version)
+	d.Set("version_description", view.VersionDescription)
+	d.Set("view_content_sha256", view.ViewContentSha256)
+	d.Set("view_id", viewID)
+
+	return diags
+}
+
+func resourceViewVersionDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConnectClient(ctx)
+
+	instanceID, viewID, version, err := viewVersionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Connect View Version: %s", d.Id())
+	input := connect.DeleteViewVersionInput{
+		InstanceId:  aws.String(instanceID),
+		ViewId:      aws.String(viewID),
+		ViewVersion: aws.Int32(version),
+	}
+	_, err = conn.DeleteViewVersion(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Connect View Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const viewVersionResourceIDSeparator = ":"
+
+func viewVersionCreateResourceID(instanceID, viewID string, version int32) string {
+	parts := []string{instanceID, viewID, strconv.Itoa(int(version))}
+	id := strings.Join(parts, viewVersionResourceIDSeparator)
+
+	return id
+}
+
+func viewVersionParseResourceID(id string) (string, string, int32, error) {
+	parts := strings.Split(id, viewVersionResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		version, err := strconv.ParseInt(parts[2], 10, 32)
+		if err != nil {
+			return "", "", 0, fmt.Errorf("parsing version (%s): %w", parts[2], err)
+		}
+
+		return parts[0], parts[1], int32(version), nil
+	}
+
+	return "", "", 0, fmt.Errorf("unexpected format of ID (%[1]s), expected instanceID%[2]sviewID%[2]sversion", id, viewVersionResourceIDSeparator)
+}
+
+func findViewVersionByThreePartKey(ctx context.Context, conn *connect.Client, instanceID, viewID string, version int32) (*awstypes.View, error) {
+	// A specific view version is addressed using a qualified view identifier.
+	input := &connect.DescribeViewInput{
+		InstanceId: aws.String(instanceID),
+		ViewId:     aws.String(fmt.Sprintf("%s:%d", viewID, version)),
+	}
+
+	output, err := findView(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Version != version {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
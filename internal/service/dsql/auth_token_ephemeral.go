@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @EphemeralResource("aws_dsql_auth_token", name="Auth Token")
+func newAuthTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+const (
+	authTokenActionConnect      = "DbConnect"
+	authTokenActionConnectAdmin = "DbConnectAdmin"
+	authTokenDefaultExpiresIn   = 15 * time.Minute
+	authTokenMaxExpiresIn       = 7 * 24 * time.Hour
+	// Hex-encoded SHA-256 of an empty payload.
+	authTokenEmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	authTokenSigningName      = "dsql"
+)
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin": schema.BoolAttribute{
+				Optional: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(authTokenMaxExpiresIn.Seconds())),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	hostname := data.Hostname.ValueString()
+	expiresIn := authTokenDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	credentials, err := e.Meta().CredentialsProvider(ctx).Retrieve(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddError("retrieving AWS credentials", err.Error())
+
+		return
+	}
+
+	token, err := generateAuthToken(ctx, hostname, e.Meta().Region(ctx), data.Admin.ValueBool(), expiresIn, credentials, time.Now())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("generating DSQL auth token (%s)", hostname), err.Error())
+
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// generateAuthToken returns a SigV4 presigned DSQL connect token for the specified cluster endpoint.
+// The token is generated locally; no API call is made.
+func generateAuthToken(ctx context.Context, hostname, region string, admin bool, expiresIn time.Duration, credentials aws.Credentials, signingTime time.Time) (string, error) {
+	action := authTokenActionConnect
+	if admin {
+		action = authTokenActionConnectAdmin
+	}
+
+	values := url.Values{
+		"Action":        []string{action},
+		"X-Amz-Expires": []string{strconv.FormatInt(int64(expiresIn.Seconds()), 10)},
+	}
+	u := url.URL{
+		Scheme:   "https",
+		Host:     hostname,
+		Path:     "/",
+		RawQuery: values.Encode(),
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, authTokenEmptyPayloadHash, authTokenSigningName, region, signingTime)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(signedURI, u.Scheme+"://"), nil
+}
+
+type authTokenEphemeralResourceModel struct {
+	Admin     types.Bool   `tfsdk:"admin"`
+	ExpiresIn types.Int64  `tfsdk:"expires_in"`
+	Hostname  types.String `tfsdk:"hostname"`
+	Token     types.String `tfsdk:"token"`
+}
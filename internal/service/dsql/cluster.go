@@ -0,0 +1,512 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dsql/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_dsql_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newClusterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &clusterResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// kmsEncryptionKeyAWSOwned is the value of kms_encryption_key that selects an AWS owned key.
+	kmsEncryptionKeyAWSOwned = "AWS_OWNED_KMS_KEY"
+
+	clusterConflictTimeout = 5 * time.Minute
+)
+
+type clusterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *clusterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"deletion_protection_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"encryption_details": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[encryptionDetailsModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[encryptionDetailsModel](ctx),
+			},
+			names.AttrIdentifier: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kms_encryption_key": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(kmsEncryptionKeyAWSOwned),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"multi_region_properties": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[multiRegionPropertiesModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+							// Only adding or removing the block forces replacement; peered clusters are managed by aws_dsql_cluster_peering.
+							response.RequiresReplace = len(request.StateValue.Elements()) != len(request.PlanValue.Elements())
+						},
+						"Adding or removing multi_region_properties forces replacement.",
+						"Adding or removing multi_region_properties forces replacement.",
+					),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"clusters": schema.SetAttribute{
+							CustomType: fwtypes.SetOfStringType,
+							Computed:   true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"witness_region": schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *clusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	var input dsql.CreateClusterInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCluster(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating DSQL Cluster", err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.Identifier)
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.Identifier = fwflex.StringValueToFramework(ctx, id)
+
+	cluster, err := waitClusterCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrIdentifier), id) // Set 'identifier' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, cluster)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *clusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id := data.Identifier.ValueString()
+	output, err := findClusterByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DSQL Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *clusterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old clusterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id := new.Identifier.ValueString()
+
+	if !new.DeletionProtectionEnabled.Equal(old.DeletionProtectionEnabled) || !new.KMSEncryptionKey.Equal(old.KMSEncryptionKey) {
+		input := dsql.UpdateClusterInput{
+			ClientToken:               aws.String(sdkid.UniqueId()),
+			DeletionProtectionEnabled: fwflex.BoolFromFramework(ctx, new.DeletionProtectionEnabled),
+			Identifier:                aws.String(id),
+		}
+
+		if !new.KMSEncryptionKey.Equal(old.KMSEncryptionKey) {
+			input.KmsEncryptionKey = fwflex.StringFromFramework(ctx, new.KMSEncryptionKey)
+		}
+
+		_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, clusterConflictTimeout, func() (any, error) {
+			return conn.UpdateCluster(ctx, &input)
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DSQL Cluster (%s)", id), err.Error())
+
+			return
+		}
+
+		timeout := r.UpdateTimeout(ctx, new.Timeouts)
+		cluster, err := waitClusterUpdated(ctx, conn, id, timeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) update", id), err.Error())
+
+			return
+		}
+
+		if !new.KMSEncryptionKey.Equal(old.KMSEncryptionKey) {
+			cluster, err = waitClusterEncryptionEnabled(ctx, conn, id, timeout)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) encryption update", id), err.Error())
+
+				return
+			}
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, cluster)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.EncryptionDetails = old.EncryptionDetails
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data clusterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DSQLClient(ctx)
+
+	id := data.Identifier.ValueString()
+	input := dsql.DeleteClusterInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+		Identifier:  aws.String(id),
+	}
+
+	// A cluster can't be deleted while it's being updated, e.g. while it's being peered.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, clusterConflictTimeout, func() (any, error) {
+		return conn.DeleteCluster(ctx, &input)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DSQL Cluster (%s)", id), err.Error())
+
+		return
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, id, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for DSQL Cluster (%s) delete", id), err.Error())
+
+		return
+	}
+}
+
+func (r *clusterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrIdentifier), request, response)
+}
+
+func findClusterByID(ctx context.Context, conn *dsql.Client, id string) (*dsql.GetClusterOutput, error) {
+	input := dsql.GetClusterInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetCluster(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	if status := output.Status; status == awstypes.ClusterStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: &input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusCluster(ctx context.Context, conn *dsql.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusClusterEncryption(ctx context.Context, conn *dsql.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.EncryptionDetails == nil {
+			return nil, "", nil
+		}
+
+		return output, string(output.EncryptionDetails.EncryptionStatus), nil
+	}
+}
+
+// A multi-Region cluster is PENDING_SETUP until it's peered.
+var clusterAvailableStatuses = []awstypes.ClusterStatus{
+	awstypes.ClusterStatusActive,
+	awstypes.ClusterStatusPendingSetup,
+}
+
+func waitClusterCreated(ctx context.Context, conn *dsql.Client, id string, timeout time.Duration) (*dsql.GetClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ClusterStatusCreating),
+		Target:                    enum.Slice(clusterAvailableStatuses...),
+		Refresh:                   statusCluster(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dsql.GetClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterUpdated(ctx context.Context, conn *dsql.Client, id string, timeout time.Duration) (*dsql.GetClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ClusterStatusUpdating),
+		Target:                    enum.Slice(clusterAvailableStatuses...),
+		Refresh:                   statusCluster(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dsql.GetClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterEncryptionEnabled(ctx context.Context, conn *dsql.Client, id string, timeout time.Duration) (*dsql.GetClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EncryptionStatusEnabling, awstypes.EncryptionStatusUpdating),
+		Target:  enum.Slice(awstypes.EncryptionStatusEnabled),
+		Refresh: statusClusterEncryption(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dsql.GetClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *dsql.Client, id string, timeout time.Duration) (*dsql.GetClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusDeleting, awstypes.ClusterStatusPendingDelete),
+		Target:  []string{},
+		Refresh: statusCluster(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dsql.GetClusterOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type clusterResourceModel struct {
+	ARN                       types.String                                                `tfsdk:"arn"`
+	DeletionProtectionEnabled types.Bool                                                  `tfsdk:"deletion_protection_enabled"`
+	EncryptionDetails         fwtypes.ListNestedObjectValueOf[encryptionDetailsModel]     `tfsdk:"encryption_details"`
+	Identifier                types.String                                                `tfsdk:"identifier"`
+	KMSEncryptionKey          types.String                                                `tfsdk:"kms_encryption_key"`
+	MultiRegionProperties     fwtypes.ListNestedObjectValueOf[multiRegionPropertiesModel] `tfsdk:"multi_region_properties"`
+	Tags                      tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                  `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+func (data *clusterResourceModel) flatten(ctx context.Context, output *dsql.GetClusterOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := output.EncryptionDetails; v != nil {
+		if v.EncryptionType == awstypes.EncryptionTypeAwsOwnedKmsKey {
+			data.KMSEncryptionKey = types.StringValue(kmsEncryptionKeyAWSOwned)
+		} else {
+			data.KMSEncryptionKey = fwflex.StringToFramework(ctx, v.KmsKeyArn)
+		}
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+type encryptionDetailsModel struct {
+	EncryptionStatus fwtypes.StringEnum[awstypes.EncryptionStatus] `tfsdk:"encryption_status"`
+	EncryptionType   fwtypes.StringEnum[awstypes.EncryptionType]   `tfsdk:"encryption_type"`
+	KMSKeyARN        types.String                                  `tfsdk:"kms_key_arn"`
+}
+
+type multiRegionPropertiesModel struct {
+	Clusters      fwtypes.SetOfString `tfsdk:"clusters"`
+	WitnessRegion types.String        `tfsdk:"witness_region"`
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+// Exports for use in tests only.
+var (
+	ResourceCluster        = newClusterResource
+	ResourceClusterPeering = newClusterPeeringResource
+
+	FindClusterByID   = findClusterByID
+	GenerateAuthToken = generateAuthToken
+)
@@ -9,8 +9,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func (p *servicePackage) withExtraOptions(_ context.Context, config map[string]any) []func(*ec2.Options) {
@@ -48,3 +52,58 @@ func (p *servicePackage) withExtraOptions(_ context.Context, config map[string]a
 		},
 	}
 }
+
+func (p *servicePackage) TagPropagations(context.Context) []*types.ServicePackageTagPropagation {
+	return []*types.ServicePackageTagPropagation{
+		{
+			TypeName:                "aws_instance",
+			ChildResourceName:       "EBS Volume",
+			ChildServicePackageName: names.EC2,
+			ListChildren:            listInstanceEBSVolumeIDs,
+		},
+		{
+			TypeName:                "aws_instance",
+			ChildResourceName:       "Network Interface",
+			ChildServicePackageName: names.EC2,
+			ListChildren:            listInstanceNetworkInterfaceIDs,
+		},
+	}
+}
+
+func listInstanceEBSVolumeIDs(ctx context.Context, meta any, id string) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := ec2.DescribeVolumesInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"attachment.instance-id": id,
+		}),
+	}
+	output, err := findEBSVolumes(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.Volume) string {
+		return aws.ToString(v.VolumeId)
+	}), nil
+}
+
+func listInstanceNetworkInterfaceIDs(ctx context.Context, meta any, id string) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := ec2.DescribeNetworkInterfacesInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"attachment.instance-id": id,
+		}),
+	}
+	output, err := findNetworkInterfaces(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.NetworkInterface) string {
+		return aws.ToString(v.NetworkInterfaceId)
+	}), nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -412,6 +413,11 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_vpc_ipam", sweepIPAMs)
 	awsv2.Register("aws_vpc_ipam_resource_discovery", sweepIPAMResourceDiscoveries)
+	awsv2.Register("aws_vpc_route_server", sweepRouteServers, "aws_vpc_route_server_endpoint", "aws_vpc_route_server_propagation", "aws_vpc_route_server_vpc_association")
+	awsv2.Register("aws_vpc_route_server_endpoint", sweepRouteServerEndpoints, "aws_vpc_route_server_peer")
+	awsv2.Register("aws_vpc_route_server_peer", sweepRouteServerPeers)
+	awsv2.Register("aws_vpc_route_server_propagation", sweepRouteServerPropagations)
+	awsv2.Register("aws_vpc_route_server_vpc_association", sweepRouteServerVPCAssociations)
 
 	resource.AddTestSweepers("aws_ami", &resource.Sweeper{
 		Name: "aws_ami",
@@ -2727,6 +2733,163 @@ func sweepIPAMResourceDiscoveries(ctx context.Context, client *conns.AWSClient)
 	return sweepResources, nil
 }
 
+func sweepRouteServers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Client(ctx)
+	var input ec2.DescribeRouteServersInput
+	var sweepResources []sweep.Sweepable
+
+	pages := ec2.NewDescribeRouteServersPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RouteServers {
+			if v.State == awstypes.RouteServerStateDeleted {
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newVPCRouteServerResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.RouteServerId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepRouteServerEndpoints(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Client(ctx)
+	var input ec2.DescribeRouteServerEndpointsInput
+	var sweepResources []sweep.Sweepable
+
+	pages := ec2.NewDescribeRouteServerEndpointsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RouteServerEndpoints {
+			if v.State == awstypes.RouteServerEndpointStateDeleted {
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newVPCRouteServerEndpointResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.RouteServerEndpointId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepRouteServerPeers(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Client(ctx)
+	var input ec2.DescribeRouteServerPeersInput
+	var sweepResources []sweep.Sweepable
+
+	pages := ec2.NewDescribeRouteServerPeersPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.RouteServerPeers {
+			if v.State == awstypes.RouteServerPeerStateDeleted {
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newVPCRouteServerPeerResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.RouteServerPeerId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepRouteServerPropagations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Client(ctx)
+	var input ec2.DescribeRouteServersInput
+	var sweepResources []sweep.Sweepable
+
+	routeServers, err := findRouteServers(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range routeServers {
+		routeServerID := aws.ToString(v.RouteServerId)
+		input := ec2.GetRouteServerPropagationsInput{
+			RouteServerId: aws.String(routeServerID),
+		}
+
+		propagations, err := findRouteServerPropagations(ctx, conn, &input)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range propagations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newVPCRouteServerPropagationResource, client,
+				framework.NewAttribute("route_server_id", routeServerID),
+				framework.NewAttribute("route_table_id", aws.ToString(v.RouteTableId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepRouteServerVPCAssociations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.EC2Client(ctx)
+	var input ec2.DescribeRouteServersInput
+	var sweepResources []sweep.Sweepable
+
+	routeServers, err := findRouteServers(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range routeServers {
+		routeServerID := aws.ToString(v.RouteServerId)
+		input := ec2.GetRouteServerAssociationsInput{
+			RouteServerId: aws.String(routeServerID),
+		}
+
+		associations, err := findRouteServerAssociations(ctx, conn, &input)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range associations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newVPCRouteServerVPCAssociationResource, client,
+				framework.NewAttribute("route_server_id", routeServerID),
+				framework.NewAttribute(names.AttrVPCID, aws.ToString(v.VpcId)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
 func sweepAMIs(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
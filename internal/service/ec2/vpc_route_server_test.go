@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteServer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RouteServer
+	resourceName := "aws_vpc_route_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rAsn := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rAsn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`route-server/rs-.+`)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("amazon_side_asn"), knownvalue.Int64Exact(int64(rAsn))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("persist_routes"), knownvalue.StringExact(string(awstypes.RouteServerPersistRoutesActionDisable))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("sns_notifications_enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						names.AttrName: knownvalue.StringExact(rName),
+					})),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RouteServer
+	resourceName := "aws_vpc_route_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rAsn := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rAsn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceVPCRouteServer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServer_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.RouteServer
+	resourceName := "aws_vpc_route_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rAsn := sdkacctest.RandIntRange(64512, 65534)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerConfig_basic(rName, rAsn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName, &v1),
+				),
+			},
+			{
+				Config: testAccVPCRouteServerConfig_persistRoutes(rName, rAsn, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerExists(ctx, resourceName, &v2),
+					testAccCheckVPCRouteServerNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrSet(resourceName, "sns_topic_arn"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("persist_routes"), knownvalue.StringExact(string(awstypes.RouteServerPersistRoutesActionEnable))),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("persist_routes_duration"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("sns_notifications_enabled"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckVPCRouteServerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_route_server" {
+				continue
+			}
+
+			_, err := tfec2.FindRouteServerByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Route Server %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVPCRouteServerExists(ctx context.Context, n string, v *awstypes.RouteServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindRouteServerByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCRouteServerNotRecreated(before, after *awstypes.RouteServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := before.RouteServerId, after.RouteServerId; *before != *after {
+			return fmt.Errorf("VPC Route Server (%s) recreated (%s)", *before, *after)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCRouteServerConfig_basic(rName string, rAsn int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc_route_server" "test" {
+  amazon_side_asn = %[2]d
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rAsn)
+}
+
+func testAccVPCRouteServerConfig_persistRoutes(rName string, rAsn, duration int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc_route_server" "test" {
+  amazon_side_asn           = %[2]d
+  persist_routes            = "enable"
+  persist_routes_duration   = %[3]d
+  sns_notifications_enabled = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rAsn, duration)
+}
+
+// testAccVPCRouteServerConfig_base creates a route server associated with a VPC that has one subnet.
+func testAccVPCRouteServerConfig_base(rName string, rAsn int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), testAccVPCRouteServerConfig_basic(rName, rAsn), `
+resource "aws_vpc_route_server_vpc_association" "test" {
+  route_server_id = aws_vpc_route_server.test.id
+  vpc_id          = aws_vpc.test.id
+}
+`)
+}
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &securityGroupRulesExclusiveResource{}, nil
+}
+
+const (
+	ResNameSecurityGroupRulesExclusive = "Security Group Rules Exclusive"
+)
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ruleIDs []string
+	resp.Diagnostics.Append(plan.SecurityGroupRuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), ruleIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := state.SecurityGroupID.ValueString()
+	_, err := findSecurityGroupByID(ctx, conn, groupID)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var out []string
+	if err == nil {
+		out, err = findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, state.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Rule IDs that are allowed but don't exist, e.g. because their rule hasn't been created yet, aren't drift.
+	// Rules that exist but aren't allowed are, so that they are revoked on the next apply.
+	var ruleIDs []string
+	resp.Diagnostics.Append(state.SecurityGroupRuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, id := range out {
+		if !slices.Contains(ruleIDs, id) {
+			ruleIDs = append(ruleIDs, id)
+		}
+	}
+
+	state.SecurityGroupRuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, ruleIDs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SecurityGroupRuleIDs.Equal(state.SecurityGroupRuleIDs) {
+		var ruleIDs []string
+		resp.Diagnostics.Append(plan.SecurityGroupRuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), ruleIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules revokes any ingress or egress rule of the security group, including
+// the default egress rule, whose ID isn't in the allowed set.
+//
+// Rules are not created by this resource. Allowed rule IDs that don't exist are ignored.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, groupID string, want []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	conns.GlobalMutexKV.Lock(groupID)
+	defer conns.GlobalMutexKV.Unlock(groupID)
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+	if err != nil {
+		return fmt.Errorf("reading Security Group (%s) rules: %w", groupID, err)
+	}
+
+	var ingress, egress []string
+	for _, rule := range rules {
+		id := aws.ToString(rule.SecurityGroupRuleId)
+		if aws.ToString(rule.GroupId) != groupID || slices.Contains(want, id) {
+			continue
+		}
+
+		if aws.ToBool(rule.IsEgress) {
+			egress = append(egress, id)
+		} else {
+			ingress = append(ingress, id)
+		}
+	}
+
+	if len(ingress) > 0 {
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: ingress,
+		}
+		_, err := conn.RevokeSecurityGroupIngress(ctx, &input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			return fmt.Errorf("revoking Security Group (%s) ingress rules (%v): %w", groupID, ingress, err)
+		}
+	}
+
+	if len(egress) > 0 {
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: egress,
+		}
+		_, err := conn.RevokeSecurityGroupEgress(ctx, &input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
+			return fmt.Errorf("revoking Security Group (%s) egress rules (%v): %w", groupID, egress, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), req, resp)
+}
+
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]string, error) {
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var ruleIDs []string
+	for _, rule := range rules {
+		if aws.ToString(rule.GroupId) == id {
+			ruleIDs = append(ruleIDs, aws.ToString(rule.SecurityGroupRuleId))
+		}
+	}
+
+	return ruleIDs, nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	SecurityGroupID      types.String `tfsdk:"security_group_id"`
+	SecurityGroupRuleIDs types.Set    `tfsdk:"security_group_rule_ids"`
+}
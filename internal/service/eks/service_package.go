@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func (p *servicePackage) TagPropagations(context.Context) []*types.ServicePackageTagPropagation {
+	return []*types.ServicePackageTagPropagation{
+		{
+			TypeName:                "aws_eks_cluster",
+			ChildResourceName:       "Network Interface",
+			ChildServicePackageName: names.EC2,
+			ListChildren:            listClusterNetworkInterfaceIDs,
+		},
+	}
+}
+
+// listClusterNetworkInterfaceIDs returns the IDs of the cross-account ENIs created for a cluster's control plane.
+func listClusterNetworkInterfaceIDs(ctx context.Context, meta any, name string) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			tfec2.NewFilter(names.AttrDescription, []string{"Amazon EKS " + name}),
+		},
+	}
+	output, err := tfec2.FindNetworkInterfaces(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v ec2types.NetworkInterface) string {
+		return aws.ToString(v.NetworkInterfaceId)
+	}), nil
+}
@@ -9,11 +9,17 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -246,3 +252,200 @@ func diffVersion(n, o *gversion.Version) (result versionDiff) {
 
 	return
 }
+
+const (
+	// valkeyMinimumEngineVersion is the earliest Valkey engine version.
+	valkeyMinimumEngineVersion = "7.2"
+
+	// redisToValkeyMinimumEngineVersion is the earliest Redis OSS engine version that ElastiCache
+	// can upgrade in place to Valkey. Older versions must first be upgraded to this version.
+	redisToValkeyMinimumEngineVersion = "5.0.6"
+)
+
+// EngineUpgradeStep is a single in-place engine upgrade.
+type EngineUpgradeStep struct {
+	Engine        string
+	EngineVersion string
+}
+
+func (s EngineUpgradeStep) String() string {
+	return s.Engine + " " + s.EngineVersion
+}
+
+// PlanEngineUpgrade returns the in-place upgrades needed to move a cache running oldEngine at oldVersion
+// to newEngine at newVersion, or an error if the upgrade path is not supported.
+//
+// oldVersion is the full version currently running, e.g. 6.2.6. newVersion is the requested version,
+// or "" if the version is not being changed.
+// minimumCrossEngineVersion is the earliest Redis OSS version that can be upgraded directly to Valkey.
+// Older versions are first upgraded to that version. An empty value places no restriction on the source version.
+//
+// Downgrades are not planned, as they require replacement of the resource.
+func PlanEngineUpgrade(oldEngine, oldVersion, newEngine, newVersion, minimumCrossEngineVersion string) ([]EngineUpgradeStep, error) {
+	if oldEngine == "" {
+		oldEngine = engineRedis
+	}
+	if newEngine == "" {
+		newEngine = oldEngine
+	}
+
+	oVersion, err := normalizeEngineVersion(oldVersion)
+	if err != nil {
+		return nil, fmt.Errorf("parsing current engine version: %w", err)
+	}
+
+	switch {
+	case oldEngine == newEngine:
+		if newVersion == "" {
+			return nil, nil
+		}
+
+		nVersion, err := normalizeEngineVersion(newVersion)
+		if err != nil {
+			return nil, fmt.Errorf("parsing new engine version: %w", err)
+		}
+
+		if !engineVersionIsUpgrade(oVersion, nVersion) {
+			return nil, nil
+		}
+
+		return []EngineUpgradeStep{{Engine: newEngine, EngineVersion: newVersion}}, nil
+
+	case oldEngine == engineRedis && newEngine == engineValkey:
+		if newVersion == "" {
+			return nil, fmt.Errorf("must explicitly set '%s' attribute when updating engine to '%s'", names.AttrEngineVersion, engineValkey)
+		}
+
+		nVersion, err := normalizeEngineVersion(newVersion)
+		if err != nil {
+			return nil, fmt.Errorf("parsing new engine version: %w", err)
+		}
+
+		if minimum := gversion.Must(gversion.NewVersion(valkeyMinimumEngineVersion)); nVersion.LessThan(minimum) {
+			return nil, fmt.Errorf("'%s' must be %s or later when updating engine to '%s', got %s", names.AttrEngineVersion, valkeyMinimumEngineVersion, engineValkey, newVersion)
+		}
+
+		var steps []EngineUpgradeStep
+
+		if minimumCrossEngineVersion != "" {
+			if minimum := gversion.Must(gversion.NewVersion(minimumCrossEngineVersion)); oVersion.LessThan(minimum) {
+				steps = append(steps, EngineUpgradeStep{Engine: engineRedis, EngineVersion: minimumCrossEngineVersion})
+			}
+		}
+
+		return append(steps, EngineUpgradeStep{Engine: engineValkey, EngineVersion: newVersion}), nil
+
+	default:
+		return nil, fmt.Errorf("in-place upgrade from engine '%s' to '%s' is not supported", oldEngine, newEngine)
+	}
+}
+
+// engineVersionIsUpgrade returns whether n is a later version than the running version o.
+// A <major>.<minor> or <major>.x version matching o is not considered an upgrade.
+func engineVersionIsUpgrade(o, n *gversion.Version) bool {
+	oSegments, nSegments := o.Segments(), n.Segments()
+
+	if nSegments[0] != oSegments[0] {
+		return nSegments[0] > oSegments[0]
+	}
+
+	if nSegments[1] == math.MaxInt {
+		return false
+	}
+
+	if nSegments[1] != oSegments[1] {
+		return nSegments[1] > oSegments[1]
+	}
+
+	return nSegments[2] > oSegments[2]
+}
+
+// EngineVersionMatches returns whether an available engine version, e.g. 7.1.0, satisfies a
+// requested engine version in any of the <major>.<minor>.<patch>, <major>.<minor> or <major>.x formats.
+func EngineVersionMatches(available, requested string) bool {
+	if available == requested {
+		return true
+	}
+
+	if prefix, ok := strings.CutSuffix(requested, ".x"); ok {
+		return strings.HasPrefix(available, prefix+".")
+	}
+
+	return strings.HasPrefix(available, requested+".")
+}
+
+// customizeDiffEngineUpgrade validates in-place `engine` and `engine_version` changes against the supported
+// upgrade paths and the engine versions available in the Region, and previews the upgrade in `engine_upgrade_steps`.
+func customizeDiffEngineUpgrade(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" || !diff.HasChanges(names.AttrEngine, names.AttrEngineVersion) {
+		return nil
+	}
+
+	if !diff.NewValueKnown(names.AttrEngine) || !diff.NewValueKnown(names.AttrEngineVersion) {
+		return nil
+	}
+
+	o, n := diff.GetChange(names.AttrEngine)
+	oldEngine, newEngine := strings.ToLower(o.(string)), strings.ToLower(n.(string))
+	if oldEngine == engineValkey && newEngine == engineRedis {
+		// Handled by replacement.
+		return nil
+	}
+
+	oldVersion := diff.Get("engine_version_actual").(string)
+	if oldVersion == "" {
+		return nil
+	}
+
+	var newVersion string
+	if diff.HasChange(names.AttrEngineVersion) {
+		newVersion = diff.Get(names.AttrEngineVersion).(string)
+	}
+
+	steps, err := PlanEngineUpgrade(oldEngine, oldVersion, newEngine, newVersion, redisToValkeyMinimumEngineVersion)
+	if err != nil {
+		return err
+	}
+
+	if len(steps) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
+
+	for _, step := range steps {
+		versions, err := findCacheEngineVersionsByEngine(ctx, conn, step.Engine)
+
+		if err != nil {
+			return fmt.Errorf("reading ElastiCache %s engine versions: %w", step.Engine, err)
+		}
+
+		if !slices.ContainsFunc(versions, func(v awstypes.CacheEngineVersion) bool {
+			return EngineVersionMatches(aws.ToString(v.EngineVersion), step.EngineVersion)
+		}) {
+			return fmt.Errorf("ElastiCache %s engine version %s is not available in this Region", step.Engine, step.EngineVersion)
+		}
+	}
+
+	return diff.SetNew("engine_upgrade_steps", tfslices.ApplyToAll(steps, EngineUpgradeStep.String))
+}
+
+func findCacheEngineVersionsByEngine(ctx context.Context, conn *elasticache.Client, engine string) ([]awstypes.CacheEngineVersion, error) {
+	input := &elasticache.DescribeCacheEngineVersionsInput{
+		Engine: aws.String(engine),
+	}
+	var output []awstypes.CacheEngineVersion
+
+	pages := elasticache.NewDescribeCacheEngineVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CacheEngineVersions...)
+	}
+
+	return output, nil
+}
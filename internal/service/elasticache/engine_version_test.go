@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -757,6 +758,172 @@ func TestVersionDiff(t *testing.T) {
 	}
 }
 
+func TestPlanEngineUpgrade(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		oldEngine, oldVersion, newEngine, newVersion string
+		minimumCrossEngineVersion                    string
+		expected                                     []string
+		expectedError                                *regexp.Regexp
+	}{
+		"no change": {
+			oldEngine:  tfelasticache.EngineRedis,
+			oldVersion: "7.1.0",
+			newEngine:  tfelasticache.EngineRedis,
+		},
+		"same minor version": {
+			oldEngine:  tfelasticache.EngineRedis,
+			oldVersion: "6.2.6",
+			newEngine:  tfelasticache.EngineRedis,
+			newVersion: "6.2",
+		},
+		"same major version 6.x": {
+			oldEngine:  tfelasticache.EngineRedis,
+			oldVersion: "6.2.6",
+			newEngine:  tfelasticache.EngineRedis,
+			newVersion: "6.x",
+		},
+		"downgrade": {
+			oldEngine:  tfelasticache.EngineRedis,
+			oldVersion: "7.1.0",
+			newEngine:  tfelasticache.EngineRedis,
+			newVersion: "6.2",
+		},
+		"minor upgrade": {
+			oldEngine:  tfelasticache.EngineRedis,
+			oldVersion: "7.0.7",
+			newEngine:  tfelasticache.EngineRedis,
+			newVersion: "7.1",
+			expected:   []string{"redis 7.1"},
+		},
+		"major upgrade": {
+			oldEngine:  tfelasticache.EngineRedis,
+			oldVersion: "5.0.6",
+			newEngine:  tfelasticache.EngineRedis,
+			newVersion: "6.2",
+			expected:   []string{"redis 6.2"},
+		},
+		"valkey minor upgrade": {
+			oldEngine:  tfelasticache.EngineValkey,
+			oldVersion: "7.2.6",
+			newEngine:  tfelasticache.EngineValkey,
+			newVersion: "8.0",
+			expected:   []string{"valkey 8.0"},
+		},
+		"redis to valkey": {
+			oldEngine:                 tfelasticache.EngineRedis,
+			oldVersion:                "7.1.0",
+			newEngine:                 tfelasticache.EngineValkey,
+			newVersion:                "7.2",
+			minimumCrossEngineVersion: "5.0.6",
+			expected:                  []string{"valkey 7.2"},
+		},
+		"redis to valkey from minimum": {
+			oldEngine:                 tfelasticache.EngineRedis,
+			oldVersion:                "5.0.6",
+			newEngine:                 tfelasticache.EngineValkey,
+			newVersion:                "8.0",
+			minimumCrossEngineVersion: "5.0.6",
+			expected:                  []string{"valkey 8.0"},
+		},
+		"redis to valkey below minimum": {
+			oldEngine:                 tfelasticache.EngineRedis,
+			oldVersion:                "4.0.10",
+			newEngine:                 tfelasticache.EngineValkey,
+			newVersion:                "7.2",
+			minimumCrossEngineVersion: "5.0.6",
+			expected:                  []string{"redis 5.0.6", "valkey 7.2"},
+		},
+		"redis to valkey no minimum": {
+			oldEngine:  tfelasticache.EngineRedis,
+			oldVersion: "6.2.6",
+			newEngine:  tfelasticache.EngineValkey,
+			newVersion: "7.2",
+			expected:   []string{"valkey 7.2"},
+		},
+		"redis to valkey no version": {
+			oldEngine:     tfelasticache.EngineRedis,
+			oldVersion:    "7.1.0",
+			newEngine:     tfelasticache.EngineValkey,
+			expectedError: regexache.MustCompile(`must explicitly set 'engine_version' attribute`),
+		},
+		"redis to valkey version too old": {
+			oldEngine:     tfelasticache.EngineRedis,
+			oldVersion:    "7.1.0",
+			newEngine:     tfelasticache.EngineValkey,
+			newVersion:    "7.1",
+			expectedError: regexache.MustCompile(`must be 7.2 or later`),
+		},
+		"valkey to redis": {
+			oldEngine:     tfelasticache.EngineValkey,
+			oldVersion:    "7.2.6",
+			newEngine:     tfelasticache.EngineRedis,
+			newVersion:    "7.1",
+			expectedError: regexache.MustCompile(`in-place upgrade from engine 'valkey' to 'redis' is not supported`),
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			steps, err := tfelasticache.PlanEngineUpgrade(testcase.oldEngine, testcase.oldVersion, testcase.newEngine, testcase.newVersion, testcase.minimumCrossEngineVersion)
+
+			if testcase.expectedError != nil {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				if !testcase.expectedError.MatchString(err.Error()) {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var actual []string
+			for _, step := range steps {
+				actual = append(actual, step.String())
+			}
+
+			if !slices.Equal(actual, testcase.expected) {
+				t.Errorf("expected %v, got %v", testcase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestEngineVersionMatches(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		available, requested string
+		expected             bool
+	}{
+		{"7.1", "7.1", true},
+		{"7.1.0", "7.1", true},
+		{"7.1.0", "7.1.0", true},
+		{"7.10", "7.1", false},
+		{"6.2.6", "6.x", true},
+		{"7.0.7", "6.x", false},
+		{"5.0.6", "5.0.6", true},
+		{"5.0.5", "5.0.6", false},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(fmt.Sprintf("%s %s", testcase.available, testcase.requested), func(t *testing.T) {
+			t.Parallel()
+
+			if actual := tfelasticache.EngineVersionMatches(testcase.available, testcase.requested); actual != testcase.expected {
+				t.Errorf("expected %t, got %t", testcase.expected, actual)
+			}
+		})
+	}
+}
+
 type mockDiff struct {
 	old, new  string
 	hasChange bool // force HasChange() to return true
@@ -142,6 +142,11 @@ func resourceReplicationGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"engine_upgrade_steps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrFinalSnapshotIdentifier: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -400,6 +405,7 @@ func resourceReplicationGroup() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			replicationGroupValidateMultiAZAutomaticFailover,
 			customizeDiffEngineVersionForceNewOnDowngrade,
+			customizeDiffEngineUpgrade,
 			customdiff.ForceNewIf(names.AttrEngine, func(_ context.Context, diff *schema.ResourceDiff, meta any) bool {
 				if !diff.HasChange(names.AttrEngine) {
 					return false
@@ -668,6 +674,7 @@ func resourceReplicationGroupRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.Set(names.AttrEngine, rgp.Engine)
+	d.Set("engine_upgrade_steps", nil)
 
 	switch rgp.AutomaticFailover {
 	case awstypes.AutomaticFailoverStatusDisabled, awstypes.AutomaticFailoverStatusDisabling:
@@ -847,12 +854,42 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			requestUpdate = true
 		}
 
-		if old, new := d.GetChange(names.AttrEngine); old.(string) == engineRedis && new.(string) == engineValkey {
-			if !d.HasChange(names.AttrEngineVersion) {
-				return sdkdiag.AppendErrorf(diags, "must explicitly set '%s' attribute for Replication Group (%s) when updating engine to 'valkey'", names.AttrEngineVersion, d.Id())
+		if d.HasChanges(names.AttrEngine, names.AttrEngineVersion) {
+			o, n := d.GetChange(names.AttrEngine)
+			oldEngine, newEngine := o.(string), n.(string)
+
+			var newVersion string
+			if d.HasChange(names.AttrEngineVersion) {
+				newVersion = d.Get(names.AttrEngineVersion).(string)
+			}
+
+			steps, err := PlanEngineUpgrade(oldEngine, d.Get("engine_version_actual").(string), newEngine, newVersion, redisToValkeyMinimumEngineVersion)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ElastiCache Replication Group (%s): %s", d.Id(), err)
+			}
+
+			// Intermediate upgrades are applied immediately, as the requested upgrade depends on them.
+			for _, step := range steps[:max(len(steps)-1, 0)] {
+				stepInput := elasticache.ModifyReplicationGroupInput{
+					ApplyImmediately:   aws.Bool(true),
+					Engine:             aws.String(step.Engine),
+					EngineVersion:      aws.String(step.EngineVersion),
+					ReplicationGroupId: aws.String(d.Id()),
+				}
+
+				updateFuncs = append(updateFuncs, func() error {
+					_, err := conn.ModifyReplicationGroup(ctx, &stepInput)
+					if err != nil {
+						return fmt.Errorf("upgrading ElastiCache Replication Group (%s) to %s: %s", d.Id(), step, err)
+					}
+					return nil
+				})
+			}
+
+			if oldEngine == engineRedis && newEngine == engineValkey {
+				input.Engine = aws.String(newEngine)
+				requestUpdate = true
 			}
-			input.Engine = aws.String(d.Get(names.AttrEngine).(string))
-			requestUpdate = true
 		}
 
 		if d.HasChange(names.AttrEngineVersion) {
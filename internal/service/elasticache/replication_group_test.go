@@ -381,6 +381,14 @@ func TestAccElastiCacheReplicationGroup_Engine_RedisToValkey(t *testing.T) {
 			},
 			{
 				Config: testAccReplicationGroupConfig_update_Valkey(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("engine_upgrade_steps"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("valkey 7.2"),
+						})),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckReplicationGroupExists(ctx, resourceName, &v2),
 					testAccCheckReplicationGroupNotRecreated(&v1, &v2),
@@ -11,6 +11,7 @@ var (
 	ResourceInstanceGroup                  = resourceInstanceGroup
 	ResourceManagedScalingPolicy           = resourceManagedScalingPolicy
 	ResourceSecurityConfiguration          = resourceSecurityConfiguration
+	ResourceStep                           = resourceStep
 	ResourceStudio                         = resourceStudio
 	ResourceStudioSessionMapping           = resourceStudioSessionMapping
 
@@ -20,6 +21,7 @@ var (
 	FindInstanceGroupByTwoPartKey      = findInstanceGroupByTwoPartKey
 	FindManagedScalingPolicyByID       = findManagedScalingPolicyByID
 	FindSecurityConfigurationByName    = findSecurityConfigurationByName
+	FindStepByTwoPartKey               = findStepByTwoPartKey
 	FindStudioByID                     = findStudioByID
 	FindStudioSessionMappingByIDOrName = findStudioSessionMappingByIDOrName
 )
@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_emr_release_labels",
 			Name:     "Release Labels",
 		},
+		{
+			Factory:  dataSourceSteps,
+			TypeName: "aws_emr_steps",
+			Name:     "Steps",
+		},
 	}
 }
 
@@ -73,6 +78,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_emr_security_configuration",
 			Name:     "Security Configuration",
 		},
+		{
+			Factory:  resourceStep,
+			TypeName: "aws_emr_step",
+			Name:     "Step",
+		},
 		{
 			Factory:  resourceStudio,
 			TypeName: "aws_emr_studio",
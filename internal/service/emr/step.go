@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emr
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/emr/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_emr_step", name="Step")
+func resourceStep() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStepCreate,
+		ReadWithoutTimeout:   resourceStepRead,
+		UpdateWithoutTimeout: resourceStepUpdate,
+		DeleteWithoutTimeout: resourceStepDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected cluster-id/step-id", d.Id())
+				}
+				clusterID := idParts[0]
+				resourceID := idParts[1]
+				d.Set("cluster_id", clusterID)
+				d.Set("wait_for_completion", true)
+				d.SetId(resourceID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action_on_failure": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.ActionOnFailureContinue,
+				ValidateDiagFunc: enum.Validate[awstypes.ActionOnFailure](),
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"end_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrExecutionRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"failure_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_file": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"hadoop_jar_step": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"args": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"jar": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"main_class": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						names.AttrProperties: {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"log_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"start_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceStepCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRClient(ctx)
+
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get(names.AttrName).(string)
+	input := &emr.AddJobFlowStepsInput{
+		JobFlowId: aws.String(clusterID),
+		Steps: []awstypes.StepConfig{{
+			ActionOnFailure: awstypes.ActionOnFailure(d.Get("action_on_failure").(string)),
+			HadoopJarStep:   expandHadoopJarStepConfig(d.Get("hadoop_jar_step").([]any)[0].(map[string]any)),
+			Name:            aws.String(name),
+		}},
+	}
+
+	if v, ok := d.GetOk(names.AttrExecutionRoleARN); ok {
+		input.ExecutionRoleArn = aws.String(v.(string))
+	}
+
+	output, err := conn.AddJobFlowSteps(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EMR Step (%s): %s", name, err)
+	}
+
+	d.SetId(output.StepIds[0])
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitStepCompleted(ctx, conn, clusterID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EMR Step (%s) complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStepRead(ctx, d, meta)...)
+}
+
+func resourceStepRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRClient(ctx)
+
+	clusterID := d.Get("cluster_id").(string)
+	step, err := findStepByTwoPartKey(ctx, conn, clusterID, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Step (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Step (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action_on_failure", step.ActionOnFailure)
+	d.Set(names.AttrExecutionRoleARN, step.ExecutionRoleArn)
+	if err := d.Set("failure_details", flattenFailureDetails(step.Status.FailureDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting failure_details: %s", err)
+	}
+	if err := d.Set("hadoop_jar_step", []any{flattenHadoopStepConfig(step.Config)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hadoop_jar_step: %s", err)
+	}
+	d.Set(names.AttrName, step.Name)
+	d.Set(names.AttrState, step.Status.State)
+	if timeline := step.Status.Timeline; timeline != nil {
+		if v := timeline.EndDateTime; v != nil {
+			d.Set("end_date_time", aws.ToTime(v).Format(time.RFC3339))
+		}
+		if v := timeline.StartDateTime; v != nil {
+			d.Set("start_date_time", aws.ToTime(v).Format(time.RFC3339))
+		}
+	}
+
+	// Step logs are written below the cluster's log URI, if any.
+	// A cluster that can no longer be described has no log URI.
+	cluster, err := findCluster(ctx, conn, &emr.DescribeClusterInput{
+		ClusterId: aws.String(clusterID),
+	})
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("log_uri", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading EMR Cluster (%s): %s", clusterID, err)
+	case aws.ToString(cluster.LogUri) != "":
+		d.Set("log_uri", fmt.Sprintf("%s/%s/steps/%s/", strings.TrimSuffix(aws.ToString(cluster.LogUri), "/"), clusterID, d.Id()))
+	default:
+		d.Set("log_uri", nil)
+	}
+
+	return diags
+}
+
+func resourceStepUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only "wait_for_completion" can be updated in-place and it has no effect on the submitted step.
+
+	return append(diags, resourceStepRead(ctx, d, meta)...)
+}
+
+func resourceStepDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRClient(ctx)
+
+	clusterID := d.Get("cluster_id").(string)
+	step, err := findStepByTwoPartKey(ctx, conn, clusterID, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Step (%s): %s", d.Id(), err)
+	}
+
+	// Steps that have already finished cannot be removed from the cluster's history.
+	switch step.Status.State {
+	case awstypes.StepStatePending, awstypes.StepStateRunning:
+	default:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Cancelling EMR Step: %s", d.Id())
+	input := &emr.CancelStepsInput{
+		ClusterId: aws.String(clusterID),
+		StepIds:   []string{d.Id()},
+	}
+
+	output, err := conn.CancelSteps(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "is not valid") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling EMR Step (%s): %s", d.Id(), err)
+	}
+
+	for _, v := range output.CancelStepsInfoList {
+		if v.Status == awstypes.CancelStepsRequestStatusFailed {
+			return sdkdiag.AppendErrorf(diags, "cancelling EMR Step (%s): %s", d.Id(), aws.ToString(v.Reason))
+		}
+	}
+
+	if _, err := waitStepCancelled(ctx, conn, clusterID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EMR Step (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findStepByTwoPartKey(ctx context.Context, conn *emr.Client, clusterID, stepID string) (*awstypes.Step, error) {
+	input := &emr.DescribeStepInput{
+		ClusterId: aws.String(clusterID),
+		StepId:    aws.String(stepID),
+	}
+
+	return findStep(ctx, conn, input)
+}
+
+func findStep(ctx context.Context, conn *emr.Client, input *emr.DescribeStepInput) (*awstypes.Step, error) {
+	output, err := conn.DescribeStep(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "is not valid") ||
+		errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "does not exist") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Step == nil || output.Step.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Step, nil
+}
+
+func statusStep(ctx context.Context, conn *emr.Client, clusterID, stepID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findStepByTwoPartKey(ctx, conn, clusterID, stepID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status.State), nil
+	}
+}
+
+func waitStepCompleted(ctx context.Context, conn *emr.Client, clusterID, stepID string, timeout time.Duration) (*awstypes.Step, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StepStatePending, awstypes.StepStateRunning),
+		Target:     enum.Slice(awstypes.StepStateCompleted),
+		Refresh:    statusStep(ctx, conn, clusterID, stepID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Step); ok {
+		if failureDetails := output.Status.FailureDetails; failureDetails != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s (log file: %s)", aws.ToString(failureDetails.Reason), aws.ToString(failureDetails.Message), aws.ToString(failureDetails.LogFile)))
+		} else if stateChangeReason := output.Status.StateChangeReason; stateChangeReason != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", stateChangeReason.Code, aws.ToString(stateChangeReason.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitStepCancelled(ctx context.Context, conn *emr.Client, clusterID, stepID string, timeout time.Duration) (*awstypes.Step, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StepStatePending, awstypes.StepStateCancelPending, awstypes.StepStateRunning),
+		Target:     enum.Slice(awstypes.StepStateCancelled, awstypes.StepStateCompleted, awstypes.StepStateFailed, awstypes.StepStateInterrupted),
+		Refresh:    statusStep(ctx, conn, clusterID, stepID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Step); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenFailureDetails(apiObject *awstypes.FailureDetails) []any {
+	if apiObject == nil {
+		return []any{}
+	}
+
+	tfMap := map[string]any{
+		"log_file":        aws.ToString(apiObject.LogFile),
+		names.AttrMessage: aws.ToString(apiObject.Message),
+		"reason":          aws.ToString(apiObject.Reason),
+	}
+
+	return []any{tfMap}
+}
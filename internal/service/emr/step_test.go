@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/emr/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemr "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRStep_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Step
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emr_step.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStepConfig_basic(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStepExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action_on_failure", string(awstypes.ActionOnFailureContinue)),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "aws_emr_cluster.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "end_date_time"),
+					resource.TestCheckResourceAttr(resourceName, "failure_details.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "hadoop_jar_step.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hadoop_jar_step.0.jar", "command-runner.jar"),
+					resource.TestCheckResourceAttr(resourceName, "hadoop_jar_step.0.args.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.StepStateCompleted)),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccStepImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTriggers},
+			},
+		},
+	})
+}
+
+func TestAccEMRStep_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.Step
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_emr_step.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStepConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "1"),
+				),
+			},
+			{
+				Config: testAccStepConfig_basic(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStepExists(ctx, resourceName, &v2),
+					testAccCheckStepRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.StepStateCompleted)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRStep_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStepConfig_failed(rName),
+				ExpectError: regexache.MustCompile(`unexpected state 'FAILED'`),
+			},
+		},
+	})
+}
+
+func testAccCheckStepExists(ctx context.Context, n string, v *awstypes.Step) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRClient(ctx)
+
+		output, err := tfemr.FindStepByTwoPartKey(ctx, conn, rs.Primary.Attributes["cluster_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckStepRecreated(before, after *awstypes.Step) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Id), aws.ToString(after.Id); before == after {
+			return fmt.Errorf("EMR Step (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccStepImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccStepConfig_basic(rName, run string) string {
+	return acctest.ConfigCompose(testAccInstanceGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_emr_step" "test" {
+  cluster_id = aws_emr_cluster.test.id
+  name       = %[1]q
+
+  hadoop_jar_step {
+    jar  = "command-runner.jar"
+    args = ["bash", "-c", "echo hello"]
+  }
+
+  triggers = {
+    run = %[2]q
+  }
+}
+`, rName, run))
+}
+
+func testAccStepConfig_failed(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_emr_step" "test" {
+  cluster_id = aws_emr_cluster.test.id
+  name       = %[1]q
+
+  hadoop_jar_step {
+    jar  = "command-runner.jar"
+    args = ["bash", "-c", "exit 1"]
+  }
+}
+`, rName))
+}
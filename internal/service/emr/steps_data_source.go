@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/emr/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_emr_steps", name="Steps")
+func dataSourceSteps() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStepsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"step_states": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.StepState](),
+				},
+			},
+			"steps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_on_failure": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStepsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRClient(ctx)
+
+	clusterID := d.Get("cluster_id").(string)
+	input := &emr.ListStepsInput{
+		ClusterId: aws.String(clusterID),
+	}
+
+	if v, ok := d.GetOk("step_states"); ok && v.(*schema.Set).Len() > 0 {
+		input.StepStates = flex.ExpandStringyValueSet[awstypes.StepState](v.(*schema.Set))
+	}
+
+	stepSummaries, err := findStepSummaries(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Steps (%s): %s", clusterID, err)
+	}
+
+	var stepIDs []string
+	var steps []any
+	for _, v := range stepSummaries {
+		stepIDs = append(stepIDs, aws.ToString(v.Id))
+
+		tfMap := map[string]any{
+			"action_on_failure": v.ActionOnFailure,
+			names.AttrID:        aws.ToString(v.Id),
+			names.AttrName:      aws.ToString(v.Name),
+		}
+		if v.Status != nil {
+			tfMap[names.AttrState] = v.Status.State
+		}
+
+		steps = append(steps, tfMap)
+	}
+
+	d.SetId(clusterID)
+	d.Set(names.AttrIDs, stepIDs)
+	if err := d.Set("steps", steps); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting steps: %s", err)
+	}
+
+	return diags
+}
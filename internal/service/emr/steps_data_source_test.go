@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emr_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRStepsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_emr_steps.test"
+	resourceName := "aws_emr_step.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStepsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "steps.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "steps.0.action_on_failure", resourceName, "action_on_failure"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "steps.0.id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "steps.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "steps.0.state", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStepsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStepConfig_basic(rName, "1"), `
+data "aws_emr_steps" "test" {
+  cluster_id  = aws_emr_step.test.cluster_id
+  step_states = ["COMPLETED"]
+}
+`)
+}
@@ -7,21 +7,25 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/document"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -315,11 +319,142 @@ func resourceCatalogTable() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									names.AttrLocation: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										RequiredWith: []string{"open_table_format_input.0.iceberg_input.0.schema"},
+									},
 									"metadata_operation": {
 										Type:         schema.TypeString,
 										Required:     true,
 										ValidateFunc: validation.StringInSlice([]string{"CREATE"}, false),
 									},
+									"partition_spec": {
+										Type:         schema.TypeList,
+										Optional:     true,
+										MaxItems:     1,
+										RequiredWith: []string{"open_table_format_input.0.iceberg_input.0.schema"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrField: {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															names.AttrName: {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+															"source_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"transform": {
+																Type:     schema.TypeString,
+																Required: true,
+																ValidateFunc: validation.StringMatch(
+																	regexache.MustCompile(`^(identity|year|month|day|hour|void|bucket\[\d+\]|truncate\[\d+\])$`),
+																	"must be a valid Iceberg partition transform",
+																),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									names.AttrProperties: {
+										Type:         schema.TypeMap,
+										Optional:     true,
+										Elem:         &schema.Schema{Type: schema.TypeString},
+										RequiredWith: []string{"open_table_format_input.0.iceberg_input.0.schema"},
+									},
+									names.AttrSchema: {
+										Type:          schema.TypeList,
+										Optional:      true,
+										MaxItems:      1,
+										RequiredWith:  []string{"open_table_format_input.0.iceberg_input.0.location"},
+										ConflictsWith: []string{"storage_descriptor"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrField: {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"doc": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															names.AttrID: {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															names.AttrName: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+															"required": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																Default:  false,
+															},
+															names.AttrType: {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringIsNotWhiteSpace,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"sort_order": {
+										Type:         schema.TypeList,
+										Optional:     true,
+										MaxItems:     1,
+										RequiredWith: []string{"open_table_format_input.0.iceberg_input.0.schema"},
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrField: {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"direction": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																Default:          awstypes.IcebergSortDirectionAsc,
+																ValidateDiagFunc: enum.Validate[awstypes.IcebergSortDirection](),
+															},
+															"null_order": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																Default:          awstypes.IcebergNullOrderNullsFirst,
+																ValidateDiagFunc: enum.Validate[awstypes.IcebergNullOrder](),
+															},
+															"source_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"transform": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Default:  "identity",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
 									names.AttrVersion: {
 										Type:         schema.TypeString,
 										Optional:     true,
@@ -412,15 +547,20 @@ func resourceCatalogTableCreate(ctx context.Context, d *schema.ResourceData, met
 	dbName := d.Get(names.AttrDatabaseName).(string)
 	name := d.Get(names.AttrName).(string)
 
+	openTableFormatInput, err := expandOpenTableFormat(d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	input := &glue.CreateTableInput{
 		CatalogId:            aws.String(catalogID),
 		DatabaseName:         aws.String(dbName),
-		OpenTableFormatInput: expandOpenTableFormat(d),
+		OpenTableFormatInput: openTableFormatInput,
 		TableInput:           expandTableInput(d),
 		PartitionIndexes:     expandTablePartitionIndexes(d.Get("partition_index").([]any)),
 	}
 
-	_, err := conn.CreateTable(ctx, input)
+	_, err = conn.CreateTable(ctx, input)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Glue Catalog Table (%s): %s", name, err)
 	}
@@ -466,8 +606,12 @@ func resourceCatalogTableRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrOwner, table.Owner)
 	d.Set("retention", table.Retention)
 
-	if err := d.Set("storage_descriptor", flattenStorageDescriptor(table.StorageDescriptor)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting storage_descriptor: %s", err)
+	// The storage descriptor of an Iceberg table whose schema is managed via open_table_format_input
+	// is maintained by AWS Glue from the table's Iceberg metadata.
+	if !icebergSchemaManaged(d) {
+		if err := d.Set("storage_descriptor", flattenStorageDescriptor(table.StorageDescriptor)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting storage_descriptor: %s", err)
+		}
 	}
 
 	if err := d.Set("partition_keys", flattenColumns(table.PartitionKeys)); err != nil {
@@ -520,6 +664,51 @@ func resourceCatalogTableUpdate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	icebergManaged := icebergSchemaManaged(d)
+
+	// Schema, partition spec, sort order and property changes to an Iceberg table are applied as
+	// Iceberg metadata updates so that field IDs and table history are preserved.
+	if icebergManaged && d.HasChange("open_table_format_input") {
+		table, err := findTableByName(ctx, conn, catalogID, dbName, name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Glue Catalog Table (%s): %s", d.Id(), err)
+		}
+
+		var columns []awstypes.Column
+		if table.StorageDescriptor != nil {
+			columns = table.StorageDescriptor.Columns
+		}
+
+		tableUpdate, err := expandIcebergTableUpdate(d.Get("open_table_format_input.0.iceberg_input.0").(map[string]any), columns)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &glue.UpdateTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(name),
+			UpdateOpenTableFormatInput: &awstypes.UpdateOpenTableFormatInput{
+				UpdateIcebergInput: &awstypes.UpdateIcebergInput{
+					UpdateIcebergTableInput: &awstypes.UpdateIcebergTableInput{
+						Updates: []awstypes.IcebergTableUpdate{*tableUpdate},
+					},
+				},
+			},
+		}
+
+		_, err = conn.UpdateTable(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Glue Catalog Table (%s) Iceberg metadata: %s", d.Id(), err)
+		}
+	}
+
+	if icebergManaged && !d.HasChangesExcept("open_table_format_input") {
+		return append(diags, resourceCatalogTableRead(ctx, d, meta)...)
+	}
+
 	input := &glue.UpdateTableInput{
 		CatalogId:    aws.String(catalogID),
 		DatabaseName: aws.String(dbName),
@@ -533,6 +722,11 @@ func resourceCatalogTableUpdate(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading Glue Catalog Table (%s): %s", d.Id(), err)
 	}
 
+	// The storage descriptor of a managed Iceberg table mirrors its Iceberg schema.
+	if icebergManaged {
+		input.TableInput.StorageDescriptor = table.StorageDescriptor
+	}
+
 	if allParameters := table.Parameters; allParameters["table_type"] == "ICEBERG" {
 		for _, k := range []string{"table_type", "metadata_location"} {
 			if v := allParameters[k]; v != "" {
@@ -657,17 +851,21 @@ func expandTableInput(d *schema.ResourceData) *awstypes.TableInput {
 	return tableInput
 }
 
-func expandOpenTableFormat(s *schema.ResourceData) *awstypes.OpenTableFormatInput {
+func expandOpenTableFormat(s *schema.ResourceData) (*awstypes.OpenTableFormatInput, error) {
 	if v, ok := s.GetOk("open_table_format_input"); ok {
+		icebergInput, err := expandIcebergInput(v.([]any)[0].(map[string]any))
+		if err != nil {
+			return nil, err
+		}
 		openTableFormatInput := &awstypes.OpenTableFormatInput{
-			IcebergInput: expandIcebergInput(v.([]any)[0].(map[string]any)),
+			IcebergInput: icebergInput,
 		}
-		return openTableFormatInput
+		return openTableFormatInput, nil
 	}
-	return nil
+	return nil, nil
 }
 
-func expandIcebergInput(s map[string]any) *awstypes.IcebergInput {
+func expandIcebergInput(s map[string]any) (*awstypes.IcebergInput, error) {
 	var iceberg = s["iceberg_input"].([]any)[0].(map[string]any)
 	icebergInput := &awstypes.IcebergInput{
 		MetadataOperation: awstypes.MetadataOperation(iceberg["metadata_operation"].(string)),
@@ -675,7 +873,292 @@ func expandIcebergInput(s map[string]any) *awstypes.IcebergInput {
 	if v, ok := iceberg[names.AttrVersion].(string); ok && v != "" {
 		icebergInput.Version = aws.String(v)
 	}
-	return icebergInput
+	if v, ok := iceberg[names.AttrSchema].([]any); ok && len(v) > 0 && v[0] != nil {
+		tableUpdate, err := expandIcebergTableUpdate(iceberg, nil)
+		if err != nil {
+			return nil, err
+		}
+		icebergInput.CreateIcebergTableInput = &awstypes.CreateIcebergTableInput{
+			Location:      tableUpdate.Location,
+			PartitionSpec: tableUpdate.PartitionSpec,
+			Properties:    tableUpdate.Properties,
+			Schema:        tableUpdate.Schema,
+			WriteOrder:    tableUpdate.SortOrder,
+		}
+	}
+	return icebergInput, nil
+}
+
+// icebergSchemaManaged returns whether the table's schema is defined by an Iceberg schema block
+// rather than by storage_descriptor columns.
+func icebergSchemaManaged(d *schema.ResourceData) bool {
+	return d.Get("open_table_format_input.0.iceberg_input.0.schema.#").(int) > 0
+}
+
+// expandIcebergTableUpdate builds the desired Iceberg table metadata from the iceberg_input block.
+// Field IDs of existing columns are resolved from the Glue columns' Iceberg parameters.
+func expandIcebergTableUpdate(tfMap map[string]any, columns []awstypes.Column) (*awstypes.IcebergTableUpdate, error) {
+	existingIDs, lastID := icebergColumnFieldIDs(columns)
+
+	icebergSchema, err := expandIcebergSchema(tfMap[names.AttrSchema].([]any)[0].(map[string]any), existingIDs, lastID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldIDs := make(map[string]int32, len(icebergSchema.Fields))
+	for _, field := range icebergSchema.Fields {
+		fieldIDs[aws.ToString(field.Name)] = field.Id
+	}
+
+	apiObject := &awstypes.IcebergTableUpdate{
+		Location: aws.String(tfMap[names.AttrLocation].(string)),
+		Schema:   icebergSchema,
+	}
+
+	if v, ok := tfMap["partition_spec"].([]any); ok && len(v) > 0 && v[0] != nil {
+		partitionSpec, err := expandIcebergPartitionSpec(v[0].(map[string]any), fieldIDs)
+		if err != nil {
+			return nil, err
+		}
+		apiObject.PartitionSpec = partitionSpec
+	}
+
+	if v, ok := tfMap[names.AttrProperties].(map[string]any); ok && len(v) > 0 {
+		apiObject.Properties = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["sort_order"].([]any); ok && len(v) > 0 && v[0] != nil {
+		sortOrder, err := expandIcebergSortOrder(v[0].(map[string]any), fieldIDs)
+		if err != nil {
+			return nil, err
+		}
+		apiObject.SortOrder = sortOrder
+	}
+
+	return apiObject, nil
+}
+
+const (
+	icebergColumnParameterFieldCurrent = "iceberg.field.current"
+	icebergColumnParameterFieldID      = "iceberg.field.id"
+)
+
+// icebergColumnFieldIDs returns the Iceberg field IDs of a table's current columns keyed by name,
+// and the highest field ID ever assigned. Columns dropped from the Iceberg schema are retained by
+// AWS Glue with iceberg.field.current set to false so that their IDs are never reused.
+func icebergColumnFieldIDs(columns []awstypes.Column) (map[string]int32, int32) {
+	ids := make(map[string]int32, len(columns))
+	var lastID int32
+
+	for i, column := range columns {
+		// Fall back to positional IDs for columns that predate Iceberg field tracking.
+		id := int32(i + 1)
+		if v, err := strconv.ParseInt(column.Parameters[icebergColumnParameterFieldID], 10, 32); err == nil {
+			id = int32(v)
+		}
+
+		lastID = max(lastID, id)
+
+		if column.Parameters[icebergColumnParameterFieldCurrent] == "false" {
+			continue
+		}
+
+		ids[aws.ToString(column.Name)] = id
+	}
+
+	return ids, lastID
+}
+
+// expandIcebergSchema assigns each field an Iceberg field ID. Explicitly configured IDs take
+// precedence (allowing renames), then existing fields are matched by name, and new fields are
+// assigned IDs above any previously assigned.
+func expandIcebergSchema(tfMap map[string]any, existingIDs map[string]int32, lastID int32) (*awstypes.IcebergSchema, error) {
+	tfList := tfMap[names.AttrField].([]any)
+
+	// IDs configured explicitly, either on top-level fields or within nested types, are reserved.
+	reservedIDs := make(map[int32]bool, len(tfList))
+	reserve := func(id int32) error {
+		if reservedIDs[id] {
+			return fmt.Errorf("Iceberg schema field ID %d is used more than once", id)
+		}
+		reservedIDs[id] = true
+		lastID = max(lastID, id)
+		return nil
+	}
+	fieldTypes := make([]document.Interface, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]any)
+		name := tfMap[names.AttrName].(string)
+
+		if v := int32(tfMap[names.AttrID].(int)); v > 0 {
+			if err := reserve(v); err != nil {
+				return nil, err
+			}
+		}
+
+		fieldType, nestedIDs, err := expandIcebergFieldType(tfMap[names.AttrType].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Iceberg schema field %q type: %w", name, err)
+		}
+		for _, v := range nestedIDs {
+			if err := reserve(v); err != nil {
+				return nil, err
+			}
+		}
+		fieldTypes[i] = fieldType
+	}
+
+	apiObject := &awstypes.IcebergSchema{
+		Type: awstypes.IcebergStructTypeEnumStruct,
+	}
+	fieldNames := make(map[string]bool, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]any)
+		name := tfMap[names.AttrName].(string)
+
+		if fieldNames[name] {
+			return nil, fmt.Errorf("Iceberg schema field %q is defined more than once", name)
+		}
+		fieldNames[name] = true
+
+		id := int32(tfMap[names.AttrID].(int))
+		if id == 0 {
+			if v, ok := existingIDs[name]; ok && !reservedIDs[v] {
+				id = v
+			} else {
+				lastID++
+				id = lastID
+			}
+		}
+
+		field := awstypes.IcebergStructField{
+			Id:       id,
+			Name:     aws.String(name),
+			Required: tfMap["required"].(bool),
+			Type:     fieldTypes[i],
+		}
+
+		if v, ok := tfMap["doc"].(string); ok && v != "" {
+			field.Doc = aws.String(v)
+		}
+
+		apiObject.Fields = append(apiObject.Fields, field)
+	}
+
+	return apiObject, nil
+}
+
+// expandIcebergFieldType accepts either a primitive type name (e.g. "long") or the JSON
+// representation of a nested Iceberg type (struct, list or map). The field IDs used within a
+// nested type are also returned.
+func expandIcebergFieldType(s string) (document.Interface, []int32, error) {
+	if !strings.HasPrefix(strings.TrimSpace(s), "{") {
+		return document.NewLazyDocument(s), nil, nil
+	}
+
+	var v map[string]any
+	if err := tfjson.DecodeFromString(s, &v); err != nil {
+		return nil, nil, err
+	}
+
+	return document.NewLazyDocument(v), icebergNestedFieldIDs(v), nil
+}
+
+// icebergNestedFieldIDs returns the field IDs declared within a JSON-serialized Iceberg type.
+func icebergNestedFieldIDs(v any) []int32 {
+	var ids []int32
+
+	switch v := v.(type) {
+	case map[string]any:
+		for _, k := range []string{names.AttrID, "element-id", "key-id", "value-id"} {
+			if id, ok := v[k].(float64); ok {
+				ids = append(ids, int32(id))
+			}
+		}
+		for _, k := range []string{"element", "fields", names.AttrKey, names.AttrType, names.AttrValue} {
+			ids = append(ids, icebergNestedFieldIDs(v[k])...)
+		}
+	case []any:
+		for _, v := range v {
+			ids = append(ids, icebergNestedFieldIDs(v)...)
+		}
+	}
+
+	return ids
+}
+
+func expandIcebergPartitionSpec(tfMap map[string]any, fieldIDs map[string]int32) (*awstypes.IcebergPartitionSpec, error) {
+	apiObject := &awstypes.IcebergPartitionSpec{}
+
+	for i, tfMapRaw := range tfMap[names.AttrField].([]any) {
+		tfMap := tfMapRaw.(map[string]any)
+		sourceName := tfMap["source_name"].(string)
+
+		sourceID, ok := fieldIDs[sourceName]
+		if !ok {
+			return nil, fmt.Errorf("Iceberg partition field source %q is not a schema field", sourceName)
+		}
+
+		transform := tfMap["transform"].(string)
+		name := tfMap[names.AttrName].(string)
+		if name == "" {
+			name = icebergPartitionFieldName(sourceName, transform)
+		}
+
+		apiObject.Fields = append(apiObject.Fields, awstypes.IcebergPartitionField{
+			// Partition field IDs start at 1000 to distinguish them from schema field IDs.
+			FieldId:   int32(1000 + i),
+			Name:      aws.String(name),
+			SourceId:  sourceID,
+			Transform: aws.String(transform),
+		})
+	}
+
+	return apiObject, nil
+}
+
+// icebergPartitionFieldName returns the default partition field name used by Iceberg.
+func icebergPartitionFieldName(sourceName, transform string) string {
+	switch {
+	case transform == "identity":
+		return sourceName
+	case transform == "void":
+		return sourceName + "_null"
+	case strings.HasPrefix(transform, "bucket["):
+		return sourceName + "_bucket"
+	case strings.HasPrefix(transform, "truncate["):
+		return sourceName + "_trunc"
+	default:
+		return sourceName + "_" + transform
+	}
+}
+
+func expandIcebergSortOrder(tfMap map[string]any, fieldIDs map[string]int32) (*awstypes.IcebergSortOrder, error) {
+	apiObject := &awstypes.IcebergSortOrder{
+		// Order ID 0 is reserved for the unsorted order.
+		OrderId: 1,
+	}
+
+	for _, tfMapRaw := range tfMap[names.AttrField].([]any) {
+		tfMap := tfMapRaw.(map[string]any)
+		sourceName := tfMap["source_name"].(string)
+
+		sourceID, ok := fieldIDs[sourceName]
+		if !ok {
+			return nil, fmt.Errorf("Iceberg sort field source %q is not a schema field", sourceName)
+		}
+
+		apiObject.Fields = append(apiObject.Fields, awstypes.IcebergSortField{
+			Direction: awstypes.IcebergSortDirection(tfMap["direction"].(string)),
+			NullOrder: awstypes.IcebergNullOrder(tfMap["null_order"].(string)),
+			SourceId:  sourceID,
+			Transform: aws.String(tfMap["transform"].(string)),
+		})
+	}
+
+	return apiObject, nil
 }
 
 func expandTablePartitionIndexes(a []any) []awstypes.PartitionIndex {
@@ -6,10 +6,13 @@ package glue_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -728,6 +731,49 @@ func TestAccGlueCatalogTable_openTableFormat(t *testing.T) {
 	})
 }
 
+func TestAccGlueCatalogTable_Iceberg_schemaEvolution(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_catalog_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableConfig_icebergSchema(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogTableExists(ctx, resourceName),
+					testAccCheckCatalogTableColumnNames(ctx, resourceName, "id", "name", "ts"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.schema.0.field.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.partition_spec.0.field.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.partition_spec.0.field.0.transform", "day"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.sort_order.0.field.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.sort_order.0.field.0.direction", "asc"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "0"),
+				),
+			},
+			{
+				Config: testAccCatalogTableConfig_icebergSchemaEvolved(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogTableExists(ctx, resourceName),
+					testAccCheckCatalogTableColumnNames(ctx, resourceName, "event_time", "id", "category"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.schema.0.field.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "open_table_format_input.0.iceberg_input.0.partition_spec.0.field.0.source_name", "event_time"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCatalogTableConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
@@ -1225,6 +1271,45 @@ func testAccCheckCatalogTableExists(ctx context.Context, n string) resource.Test
 	}
 }
 
+// testAccCheckCatalogTableColumnNames verifies the names and order of the table's current columns.
+func testAccCheckCatalogTableColumnNames(ctx context.Context, n string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		catalogID, dbName, name, err := tfglue.ReadTableID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueClient(ctx)
+
+		table, err := tfglue.FindTableByName(ctx, conn, catalogID, dbName, name)
+
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		if table.StorageDescriptor != nil {
+			for _, column := range table.StorageDescriptor.Columns {
+				if column.Parameters["iceberg.field.current"] == "false" {
+					continue
+				}
+				got = append(got, aws.ToString(column.Name))
+			}
+		}
+
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("Glue Catalog Table (%s) columns = %v, want %v", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCatalogTableConfig_partitionIndexesSingle(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
@@ -1490,3 +1575,128 @@ resource "aws_glue_catalog_table" "test" {
 }
 `, rName, columnComment)
 }
+
+func testAccCatalogTableConfig_icebergBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccCatalogTableConfig_icebergSchema(rName string) string {
+	return acctest.ConfigCompose(testAccCatalogTableConfig_icebergBase(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+  table_type    = "EXTERNAL_TABLE"
+
+  open_table_format_input {
+    iceberg_input {
+      metadata_operation = "CREATE"
+      version            = "2"
+      location           = "s3://${aws_s3_bucket.test.bucket}/%[1]s/"
+
+      schema {
+        field {
+          name     = "id"
+          type     = "long"
+          required = true
+        }
+
+        field {
+          name = "name"
+          type = "string"
+        }
+
+        field {
+          name = "ts"
+          type = "timestamp"
+        }
+      }
+
+      partition_spec {
+        field {
+          source_name = "ts"
+          transform   = "day"
+        }
+      }
+
+      sort_order {
+        field {
+          source_name = "id"
+        }
+      }
+
+      properties = {
+        "write.format.default" = "parquet"
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccCatalogTableConfig_icebergSchemaEvolved(rName string) string {
+	return acctest.ConfigCompose(testAccCatalogTableConfig_icebergBase(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+  table_type    = "EXTERNAL_TABLE"
+
+  open_table_format_input {
+    iceberg_input {
+      metadata_operation = "CREATE"
+      version            = "2"
+      location           = "s3://${aws_s3_bucket.test.bucket}/%[1]s/"
+
+      schema {
+        # Renamed from "ts" and moved to the front.
+        field {
+          id   = 3
+          name = "event_time"
+          type = "timestamp"
+        }
+
+        field {
+          name     = "id"
+          type     = "long"
+          required = true
+        }
+
+        # "name" dropped and "category" added.
+        field {
+          name = "category"
+          type = "string"
+          doc  = "Event category"
+        }
+      }
+
+      partition_spec {
+        field {
+          source_name = "event_time"
+          transform   = "day"
+        }
+      }
+
+      sort_order {
+        field {
+          source_name = "id"
+          direction   = "desc"
+          null_order  = "nulls-last"
+        }
+      }
+
+      properties = {
+        "write.format.default" = "parquet"
+      }
+    }
+  }
+}
+`, rName))
+}
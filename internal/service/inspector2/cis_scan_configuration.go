@@ -0,0 +1,485 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_inspector2_cis_scan_configuration", name="CIS Scan Configuration")
+// @Tags(identifierAttribute="arn")
+func resourceCISScanConfiguration() *schema.Resource {
+	startTimeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time_of_day": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([0-1]?[0-9]|2[0-3]):[0-5][0-9]$`), "must be in HH:MM format"),
+					},
+					"timezone": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 50),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCISScanConfigurationCreate,
+		ReadWithoutTimeout:   resourceCISScanConfigurationRead,
+		UpdateWithoutTimeout: resourceCISScanConfigurationUpdate,
+		DeleteWithoutTimeout: resourceCISScanConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"scan_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrSchedule: {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"schedule.0.daily", "schedule.0.monthly", "schedule.0.one_time", "schedule.0.weekly"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrStartTime: startTimeSchema(),
+								},
+							},
+						},
+						"monthly": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.Day](),
+									},
+									names.AttrStartTime: startTimeSchema(),
+								},
+							},
+						},
+						"one_time": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{},
+							},
+						},
+						"weekly": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 7,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.Day](),
+										},
+									},
+									names.AttrStartTime: startTimeSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"security_level": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.CisSecurityLevel](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"targets": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 10000,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.Any(verify.ValidAccountID, validation.StringInSlice([]string{"ALL_ACCOUNTS"}, false)),
+							},
+						},
+						"target_resource_tags": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrKey: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									names.AttrValues: {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 5,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCISScanConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	name := d.Get("scan_name").(string)
+	input := &inspector2.CreateCisScanConfigurationInput{
+		ScanName:      aws.String(name),
+		Schedule:      expandCISSchedule(d.Get(names.AttrSchedule).([]any)),
+		SecurityLevel: awstypes.CisSecurityLevel(d.Get("security_level").(string)),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("targets"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+		input.Targets = &awstypes.CreateCisTargets{
+			AccountIds:         flex.ExpandStringValueSet(tfMap["account_ids"].(*schema.Set)),
+			TargetResourceTags: expandCISTargetResourceTags(tfMap["target_resource_tags"].(*schema.Set).List()),
+		}
+	}
+
+	output, err := conn.CreateCisScanConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Inspector2 CIS Scan Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ScanConfigurationArn))
+
+	return append(diags, resourceCISScanConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceCISScanConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	scanConfiguration, err := findCISScanConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector2 CIS Scan Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Inspector2 CIS Scan Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, scanConfiguration.ScanConfigurationArn)
+	d.Set("scan_name", scanConfiguration.ScanName)
+	if err := d.Set(names.AttrSchedule, flattenCISSchedule(scanConfiguration.Schedule)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schedule: %s", err)
+	}
+	d.Set("security_level", scanConfiguration.SecurityLevel)
+	if err := d.Set("targets", flattenCISTargets(scanConfiguration.Targets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting targets: %s", err)
+	}
+
+	setTagsOut(ctx, scanConfiguration.Tags)
+
+	return diags
+}
+
+func resourceCISScanConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &inspector2.UpdateCisScanConfigurationInput{
+			ScanConfigurationArn: aws.String(d.Id()),
+			ScanName:             aws.String(d.Get("scan_name").(string)),
+			Schedule:             expandCISSchedule(d.Get(names.AttrSchedule).([]any)),
+			SecurityLevel:        awstypes.CisSecurityLevel(d.Get("security_level").(string)),
+		}
+
+		if v, ok := d.GetOk("targets"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			tfMap := v.([]any)[0].(map[string]any)
+			input.Targets = &awstypes.UpdateCisTargets{
+				AccountIds:         flex.ExpandStringValueSet(tfMap["account_ids"].(*schema.Set)),
+				TargetResourceTags: expandCISTargetResourceTags(tfMap["target_resource_tags"].(*schema.Set).List()),
+			}
+		}
+
+		_, err := conn.UpdateCisScanConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Inspector2 CIS Scan Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCISScanConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceCISScanConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Inspector2 CIS Scan Configuration: %s", d.Id())
+	_, err := conn.DeleteCisScanConfiguration(ctx, &inspector2.DeleteCisScanConfigurationInput{
+		ScanConfigurationArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Inspector2 CIS Scan Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findCISScanConfigurationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.CisScanConfiguration, error) {
+	input := &inspector2.ListCisScanConfigurationsInput{
+		FilterCriteria: &awstypes.ListCisScanConfigurationsFilterCriteria{
+			ScanConfigurationArnFilters: []awstypes.CisStringFilter{
+				{
+					Comparison: awstypes.CisStringComparisonEquals,
+					Value:      aws.String(arn),
+				},
+			},
+		},
+	}
+
+	return findCISScanConfiguration(ctx, conn, input)
+}
+
+func findCISScanConfiguration(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) (*awstypes.CisScanConfiguration, error) {
+	output, err := findCISScanConfigurations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCISScanConfigurations(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) ([]awstypes.CisScanConfiguration, error) {
+	var output []awstypes.CisScanConfiguration
+
+	pages := inspector2.NewListCisScanConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ScanConfigurations...)
+	}
+
+	return output, nil
+}
+
+func expandCISSchedule(tfList []any) awstypes.Schedule {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+
+	if v, ok := tfMap["daily"].([]any); ok && len(v) > 0 && v[0] != nil {
+		return &awstypes.ScheduleMemberDaily{
+			Value: awstypes.DailySchedule{
+				StartTime: expandCISTime(v[0].(map[string]any)[names.AttrStartTime].([]any)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["monthly"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+
+		return &awstypes.ScheduleMemberMonthly{
+			Value: awstypes.MonthlySchedule{
+				Day:       awstypes.Day(tfMap["day"].(string)),
+				StartTime: expandCISTime(tfMap[names.AttrStartTime].([]any)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["one_time"].([]any); ok && len(v) > 0 {
+		return &awstypes.ScheduleMemberOneTime{
+			Value: awstypes.OneTimeSchedule{},
+		}
+	}
+
+	if v, ok := tfMap["weekly"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+
+		return &awstypes.ScheduleMemberWeekly{
+			Value: awstypes.WeeklySchedule{
+				Days:      flex.ExpandStringyValueSet[awstypes.Day](tfMap["days"].(*schema.Set)),
+				StartTime: expandCISTime(tfMap[names.AttrStartTime].([]any)),
+			},
+		}
+	}
+
+	return nil
+}
+
+func expandCISTime(tfList []any) *awstypes.Time {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+
+	return &awstypes.Time{
+		TimeOfDay: aws.String(tfMap["time_of_day"].(string)),
+		Timezone:  aws.String(tfMap["timezone"].(string)),
+	}
+}
+
+func expandCISTargetResourceTags(tfList []any) map[string][]string {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := make(map[string][]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject[tfMap[names.AttrKey].(string)] = flex.ExpandStringValueSet(tfMap[names.AttrValues].(*schema.Set))
+	}
+
+	return apiObject
+}
+
+func flattenCISSchedule(apiObject awstypes.Schedule) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	switch v := apiObject.(type) {
+	case *awstypes.ScheduleMemberDaily:
+		tfMap["daily"] = []any{map[string]any{
+			names.AttrStartTime: flattenCISTime(v.Value.StartTime),
+		}}
+	case *awstypes.ScheduleMemberMonthly:
+		tfMap["monthly"] = []any{map[string]any{
+			"day":               v.Value.Day,
+			names.AttrStartTime: flattenCISTime(v.Value.StartTime),
+		}}
+	case *awstypes.ScheduleMemberOneTime:
+		tfMap["one_time"] = []any{map[string]any{}}
+	case *awstypes.ScheduleMemberWeekly:
+		tfMap["weekly"] = []any{map[string]any{
+			"days":              flex.FlattenStringyValueSet(v.Value.Days),
+			names.AttrStartTime: flattenCISTime(v.Value.StartTime),
+		}}
+	}
+
+	return []any{tfMap}
+}
+
+func flattenCISTime(apiObject *awstypes.Time) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"time_of_day": aws.ToString(apiObject.TimeOfDay),
+		"timezone":    aws.ToString(apiObject.Timezone),
+	}
+
+	return []any{tfMap}
+}
+
+func flattenCISTargets(apiObject *awstypes.CisTargets) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []any
+
+	for k, v := range apiObject.TargetResourceTags {
+		tfList = append(tfList, map[string]any{
+			names.AttrKey:    k,
+			names.AttrValues: v,
+		})
+	}
+
+	tfMap := map[string]any{
+		"account_ids":          apiObject.AccountIds,
+		"target_resource_tags": tfList,
+	}
+
+	return []any{tfMap}
+}
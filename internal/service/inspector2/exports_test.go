@@ -5,11 +5,15 @@ package inspector2
 
 // Exports for use in tests only.
 var (
+	ResourceCISScanConfiguration      = resourceCISScanConfiguration
 	ResourceDelegatedAdminAccount     = resourceDelegatedAdminAccount
+	ResourceFilter                    = resourceFilter
 	ResourceMemberAssociation         = resourceMemberAssociation
 	ResourceOrganizationConfiguration = resourceOrganizationConfiguration
 
+	FindCISScanConfigurationByARN = findCISScanConfigurationByARN
 	FindDelegatedAdminAccountByID = findDelegatedAdminAccountByID
+	FindFilterByARN               = findFilterByARN
 	FindMemberByAccountID         = findMemberByAccountID
 	FindOrganizationConfiguration = findOrganizationConfiguration
 
@@ -0,0 +1,798 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_inspector2_filter", name="Filter")
+// @Tags(identifierAttribute="arn")
+func resourceFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFilterCreate,
+		ReadWithoutTimeout:   resourceFilterRead,
+		UpdateWithoutTimeout: resourceFilterUpdate,
+		DeleteWithoutTimeout: resourceFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAction: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.FilterAction](),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: filterCriteriaSchema(),
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func filterCriteriaSchema() map[string]*schema.Schema {
+	dateFilterSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"end_inclusive": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+					"start_inclusive": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+				},
+			},
+		}
+	}
+	numberFilterSchema := func(maxItems int) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: maxItems,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"lower_inclusive": {
+						Type:     schema.TypeFloat,
+						Required: true,
+					},
+					"upper_inclusive": {
+						Type:     schema.TypeFloat,
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+	stringFilterSchema := func(maxItems int) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: maxItems,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"comparison": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: enum.Validate[awstypes.StringComparison](),
+					},
+					names.AttrValue: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1024),
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"aws_account_id":                     stringFilterSchema(10),
+		"code_vulnerability_detector_name":   stringFilterSchema(10),
+		"code_vulnerability_detector_tags":   stringFilterSchema(10),
+		"code_vulnerability_file_path":       stringFilterSchema(10),
+		"component_id":                       stringFilterSchema(10),
+		"component_type":                     stringFilterSchema(10),
+		"ec2_instance_image_id":              stringFilterSchema(10),
+		"ec2_instance_subnet_id":             stringFilterSchema(10),
+		"ec2_instance_vpc_id":                stringFilterSchema(10),
+		"ecr_image_architecture":             stringFilterSchema(10),
+		"ecr_image_hash":                     stringFilterSchema(10),
+		"ecr_image_pushed_at":                dateFilterSchema(),
+		"ecr_image_registry":                 stringFilterSchema(10),
+		"ecr_image_repository_name":          stringFilterSchema(10),
+		"ecr_image_tags":                     stringFilterSchema(10),
+		"epss_score":                         numberFilterSchema(10),
+		"exploit_available":                  stringFilterSchema(10),
+		"finding_arn":                        stringFilterSchema(10),
+		"finding_status":                     stringFilterSchema(10),
+		"finding_type":                       stringFilterSchema(10),
+		"first_observed_at":                  dateFilterSchema(),
+		"fix_available":                      stringFilterSchema(10),
+		"inspector_score":                    numberFilterSchema(10),
+		"lambda_function_execution_role_arn": stringFilterSchema(10),
+		"lambda_function_last_modified_at":   dateFilterSchema(),
+		"lambda_function_layers":             stringFilterSchema(10),
+		"lambda_function_name":               stringFilterSchema(10),
+		"lambda_function_runtime":            stringFilterSchema(10),
+		"last_observed_at":                   dateFilterSchema(),
+		"network_protocol":                   stringFilterSchema(10),
+		"port_range": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"begin_inclusive": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IsPortNumberOrZero,
+					},
+					"end_inclusive": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IsPortNumberOrZero,
+					},
+				},
+			},
+		},
+		"related_vulnerabilities": stringFilterSchema(10),
+		names.AttrResourceID:      stringFilterSchema(10),
+		"resource_tags": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"comparison": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: enum.Validate[awstypes.MapComparison](),
+					},
+					names.AttrKey: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					names.AttrValue: {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 256),
+					},
+				},
+			},
+		},
+		names.AttrResourceType: stringFilterSchema(10),
+		"severity":             stringFilterSchema(10),
+		"title":                stringFilterSchema(10),
+		"updated_at":           dateFilterSchema(),
+		"vendor_severity":      stringFilterSchema(10),
+		"vulnerability_id":     stringFilterSchema(10),
+		"vulnerability_source": stringFilterSchema(10),
+		"vulnerable_packages": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"architecture":            stringFilterSchema(1),
+					"epoch":                   numberFilterSchema(1),
+					names.AttrName:            stringFilterSchema(1),
+					"release":                 stringFilterSchema(1),
+					"source_lambda_layer_arn": stringFilterSchema(1),
+					"source_layer_hash":       stringFilterSchema(1),
+					names.AttrVersion:         stringFilterSchema(1),
+				},
+			},
+		},
+	}
+}
+
+func resourceFilterCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &inspector2.CreateFilterInput{
+		Action: awstypes.FilterAction(d.Get(names.AttrAction).(string)),
+		Name:   aws.String(name),
+		Tags:   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.FilterCriteria = expandFilterCriteria(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("reason"); ok {
+		input.Reason = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateFilter(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Inspector2 Filter (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
+}
+
+func resourceFilterRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	filter, err := findFilterByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector2 Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Inspector2 Filter (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrAction, filter.Action)
+	d.Set(names.AttrARN, filter.Arn)
+	d.Set(names.AttrDescription, filter.Description)
+	if filter.Criteria != nil {
+		if err := d.Set("filter_criteria", []any{flattenFilterCriteria(filter.Criteria)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting filter_criteria: %s", err)
+		}
+	} else {
+		d.Set("filter_criteria", nil)
+	}
+	d.Set(names.AttrName, filter.Name)
+	d.Set("reason", filter.Reason)
+
+	setTagsOut(ctx, filter.Tags)
+
+	return diags
+}
+
+func resourceFilterUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &inspector2.UpdateFilterInput{
+			Action:      awstypes.FilterAction(d.Get(names.AttrAction).(string)),
+			Description: aws.String(d.Get(names.AttrDescription).(string)),
+			FilterArn:   aws.String(d.Id()),
+			Name:        aws.String(d.Get(names.AttrName).(string)),
+			Reason:      aws.String(d.Get("reason").(string)),
+		}
+
+		if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			input.FilterCriteria = expandFilterCriteria(v.([]any)[0].(map[string]any))
+		}
+
+		_, err := conn.UpdateFilter(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Inspector2 Filter (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFilterRead(ctx, d, meta)...)
+}
+
+func resourceFilterDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Inspector2 Filter: %s", d.Id())
+	_, err := conn.DeleteFilter(ctx, &inspector2.DeleteFilterInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Inspector2 Filter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.Filter, error) {
+	input := &inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	return findFilter(ctx, conn, input)
+}
+
+func findFilter(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) (*awstypes.Filter, error) {
+	output, err := findFilters(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFilters(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) ([]awstypes.Filter, error) {
+	var output []awstypes.Filter
+
+	pages := inspector2.NewListFiltersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Filters...)
+	}
+
+	return output, nil
+}
+
+func expandFilterCriteria(tfMap map[string]any) *awstypes.FilterCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.FilterCriteria{
+		AwsAccountId:                   expandStringFilters(tfMap["aws_account_id"].([]any)),
+		CodeVulnerabilityDetectorName:  expandStringFilters(tfMap["code_vulnerability_detector_name"].([]any)),
+		CodeVulnerabilityDetectorTags:  expandStringFilters(tfMap["code_vulnerability_detector_tags"].([]any)),
+		CodeVulnerabilityFilePath:      expandStringFilters(tfMap["code_vulnerability_file_path"].([]any)),
+		ComponentId:                    expandStringFilters(tfMap["component_id"].([]any)),
+		ComponentType:                  expandStringFilters(tfMap["component_type"].([]any)),
+		Ec2InstanceImageId:             expandStringFilters(tfMap["ec2_instance_image_id"].([]any)),
+		Ec2InstanceSubnetId:            expandStringFilters(tfMap["ec2_instance_subnet_id"].([]any)),
+		Ec2InstanceVpcId:               expandStringFilters(tfMap["ec2_instance_vpc_id"].([]any)),
+		EcrImageArchitecture:           expandStringFilters(tfMap["ecr_image_architecture"].([]any)),
+		EcrImageHash:                   expandStringFilters(tfMap["ecr_image_hash"].([]any)),
+		EcrImagePushedAt:               expandDateFilters(tfMap["ecr_image_pushed_at"].([]any)),
+		EcrImageRegistry:               expandStringFilters(tfMap["ecr_image_registry"].([]any)),
+		EcrImageRepositoryName:         expandStringFilters(tfMap["ecr_image_repository_name"].([]any)),
+		EcrImageTags:                   expandStringFilters(tfMap["ecr_image_tags"].([]any)),
+		EpssScore:                      expandNumberFilters(tfMap["epss_score"].([]any)),
+		ExploitAvailable:               expandStringFilters(tfMap["exploit_available"].([]any)),
+		FindingArn:                     expandStringFilters(tfMap["finding_arn"].([]any)),
+		FindingStatus:                  expandStringFilters(tfMap["finding_status"].([]any)),
+		FindingType:                    expandStringFilters(tfMap["finding_type"].([]any)),
+		FirstObservedAt:                expandDateFilters(tfMap["first_observed_at"].([]any)),
+		FixAvailable:                   expandStringFilters(tfMap["fix_available"].([]any)),
+		InspectorScore:                 expandNumberFilters(tfMap["inspector_score"].([]any)),
+		LambdaFunctionExecutionRoleArn: expandStringFilters(tfMap["lambda_function_execution_role_arn"].([]any)),
+		LambdaFunctionLastModifiedAt:   expandDateFilters(tfMap["lambda_function_last_modified_at"].([]any)),
+		LambdaFunctionLayers:           expandStringFilters(tfMap["lambda_function_layers"].([]any)),
+		LambdaFunctionName:             expandStringFilters(tfMap["lambda_function_name"].([]any)),
+		LambdaFunctionRuntime:          expandStringFilters(tfMap["lambda_function_runtime"].([]any)),
+		LastObservedAt:                 expandDateFilters(tfMap["last_observed_at"].([]any)),
+		NetworkProtocol:                expandStringFilters(tfMap["network_protocol"].([]any)),
+		PortRange:                      expandPortRangeFilters(tfMap["port_range"].([]any)),
+		RelatedVulnerabilities:         expandStringFilters(tfMap["related_vulnerabilities"].([]any)),
+		ResourceId:                     expandStringFilters(tfMap[names.AttrResourceID].([]any)),
+		ResourceTags:                   expandMapFilters(tfMap["resource_tags"].([]any)),
+		ResourceType:                   expandStringFilters(tfMap[names.AttrResourceType].([]any)),
+		Severity:                       expandStringFilters(tfMap["severity"].([]any)),
+		Title:                          expandStringFilters(tfMap["title"].([]any)),
+		UpdatedAt:                      expandDateFilters(tfMap["updated_at"].([]any)),
+		VendorSeverity:                 expandStringFilters(tfMap["vendor_severity"].([]any)),
+		VulnerabilityId:                expandStringFilters(tfMap["vulnerability_id"].([]any)),
+		VulnerabilitySource:            expandStringFilters(tfMap["vulnerability_source"].([]any)),
+		VulnerablePackages:             expandPackageFilters(tfMap["vulnerable_packages"].([]any)),
+	}
+
+	return apiObject
+}
+
+func expandStringFilters(tfList []any) []awstypes.StringFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.StringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.StringFilter{
+			Comparison: awstypes.StringComparison(tfMap["comparison"].(string)),
+			Value:      aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandStringFilter(tfList []any) *awstypes.StringFilter {
+	if apiObjects := expandStringFilters(tfList); len(apiObjects) > 0 {
+		return &apiObjects[0]
+	}
+
+	return nil
+}
+
+func expandDateFilters(tfList []any) []awstypes.DateFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.DateFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.DateFilter{}
+
+		if v, ok := tfMap["end_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.EndInclusive = aws.Time(v)
+		}
+
+		if v, ok := tfMap["start_inclusive"].(string); ok && v != "" {
+			v, _ := time.Parse(time.RFC3339, v)
+			apiObject.StartInclusive = aws.Time(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandNumberFilters(tfList []any) []awstypes.NumberFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.NumberFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.NumberFilter{
+			LowerInclusive: aws.Float64(tfMap["lower_inclusive"].(float64)),
+			UpperInclusive: aws.Float64(tfMap["upper_inclusive"].(float64)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandMapFilters(tfList []any) []awstypes.MapFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.MapFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.MapFilter{
+			Comparison: awstypes.MapComparison(tfMap["comparison"].(string)),
+			Key:        aws.String(tfMap[names.AttrKey].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
+			apiObject.Value = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPortRangeFilters(tfList []any) []awstypes.PortRangeFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.PortRangeFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.PortRangeFilter{
+			BeginInclusive: aws.Int32(int32(tfMap["begin_inclusive"].(int))),
+			EndInclusive:   aws.Int32(int32(tfMap["end_inclusive"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandPackageFilters(tfList []any) []awstypes.PackageFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.PackageFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.PackageFilter{
+			Architecture:         expandStringFilter(tfMap["architecture"].([]any)),
+			Name:                 expandStringFilter(tfMap[names.AttrName].([]any)),
+			Release:              expandStringFilter(tfMap["release"].([]any)),
+			SourceLambdaLayerArn: expandStringFilter(tfMap["source_lambda_layer_arn"].([]any)),
+			SourceLayerHash:      expandStringFilter(tfMap["source_layer_hash"].([]any)),
+			Version:              expandStringFilter(tfMap[names.AttrVersion].([]any)),
+		}
+
+		if v := expandNumberFilters(tfMap["epoch"].([]any)); len(v) > 0 {
+			apiObject.Epoch = &v[0]
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFilterCriteria(apiObject *awstypes.FilterCriteria) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"aws_account_id":                     flattenStringFilters(apiObject.AwsAccountId),
+		"code_vulnerability_detector_name":   flattenStringFilters(apiObject.CodeVulnerabilityDetectorName),
+		"code_vulnerability_detector_tags":   flattenStringFilters(apiObject.CodeVulnerabilityDetectorTags),
+		"code_vulnerability_file_path":       flattenStringFilters(apiObject.CodeVulnerabilityFilePath),
+		"component_id":                       flattenStringFilters(apiObject.ComponentId),
+		"component_type":                     flattenStringFilters(apiObject.ComponentType),
+		"ec2_instance_image_id":              flattenStringFilters(apiObject.Ec2InstanceImageId),
+		"ec2_instance_subnet_id":             flattenStringFilters(apiObject.Ec2InstanceSubnetId),
+		"ec2_instance_vpc_id":                flattenStringFilters(apiObject.Ec2InstanceVpcId),
+		"ecr_image_architecture":             flattenStringFilters(apiObject.EcrImageArchitecture),
+		"ecr_image_hash":                     flattenStringFilters(apiObject.EcrImageHash),
+		"ecr_image_pushed_at":                flattenDateFilters(apiObject.EcrImagePushedAt),
+		"ecr_image_registry":                 flattenStringFilters(apiObject.EcrImageRegistry),
+		"ecr_image_repository_name":          flattenStringFilters(apiObject.EcrImageRepositoryName),
+		"ecr_image_tags":                     flattenStringFilters(apiObject.EcrImageTags),
+		"epss_score":                         flattenNumberFilters(apiObject.EpssScore),
+		"exploit_available":                  flattenStringFilters(apiObject.ExploitAvailable),
+		"finding_arn":                        flattenStringFilters(apiObject.FindingArn),
+		"finding_status":                     flattenStringFilters(apiObject.FindingStatus),
+		"finding_type":                       flattenStringFilters(apiObject.FindingType),
+		"first_observed_at":                  flattenDateFilters(apiObject.FirstObservedAt),
+		"fix_available":                      flattenStringFilters(apiObject.FixAvailable),
+		"inspector_score":                    flattenNumberFilters(apiObject.InspectorScore),
+		"lambda_function_execution_role_arn": flattenStringFilters(apiObject.LambdaFunctionExecutionRoleArn),
+		"lambda_function_last_modified_at":   flattenDateFilters(apiObject.LambdaFunctionLastModifiedAt),
+		"lambda_function_layers":             flattenStringFilters(apiObject.LambdaFunctionLayers),
+		"lambda_function_name":               flattenStringFilters(apiObject.LambdaFunctionName),
+		"lambda_function_runtime":            flattenStringFilters(apiObject.LambdaFunctionRuntime),
+		"last_observed_at":                   flattenDateFilters(apiObject.LastObservedAt),
+		"network_protocol":                   flattenStringFilters(apiObject.NetworkProtocol),
+		"port_range":                         flattenPortRangeFilters(apiObject.PortRange),
+		"related_vulnerabilities":            flattenStringFilters(apiObject.RelatedVulnerabilities),
+		names.AttrResourceID:                 flattenStringFilters(apiObject.ResourceId),
+		"resource_tags":                      flattenMapFilters(apiObject.ResourceTags),
+		names.AttrResourceType:               flattenStringFilters(apiObject.ResourceType),
+		"severity":                           flattenStringFilters(apiObject.Severity),
+		"title":                              flattenStringFilters(apiObject.Title),
+		"updated_at":                         flattenDateFilters(apiObject.UpdatedAt),
+		"vendor_severity":                    flattenStringFilters(apiObject.VendorSeverity),
+		"vulnerability_id":                   flattenStringFilters(apiObject.VulnerabilityId),
+		"vulnerability_source":               flattenStringFilters(apiObject.VulnerabilitySource),
+		"vulnerable_packages":                flattenPackageFilters(apiObject.VulnerablePackages),
+	}
+
+	return tfMap
+}
+
+func flattenStringFilters(apiObjects []awstypes.StringFilter) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"comparison":    apiObject.Comparison,
+			names.AttrValue: aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenStringFilter(apiObject *awstypes.StringFilter) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	return flattenStringFilters([]awstypes.StringFilter{*apiObject})
+}
+
+func flattenDateFilters(apiObjects []awstypes.DateFilter) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{}
+
+		if v := apiObject.EndInclusive; v != nil {
+			tfMap["end_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartInclusive; v != nil {
+			tfMap["start_inclusive"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenNumberFilters(apiObjects []awstypes.NumberFilter) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"lower_inclusive": aws.ToFloat64(apiObject.LowerInclusive),
+			"upper_inclusive": aws.ToFloat64(apiObject.UpperInclusive),
+		})
+	}
+
+	return tfList
+}
+
+func flattenMapFilters(apiObjects []awstypes.MapFilter) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"comparison":    apiObject.Comparison,
+			names.AttrKey:   aws.ToString(apiObject.Key),
+			names.AttrValue: aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPortRangeFilters(apiObjects []awstypes.PortRangeFilter) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"begin_inclusive": aws.ToInt32(apiObject.BeginInclusive),
+			"end_inclusive":   aws.ToInt32(apiObject.EndInclusive),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPackageFilters(apiObjects []awstypes.PackageFilter) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"architecture":            flattenStringFilter(apiObject.Architecture),
+			names.AttrName:            flattenStringFilter(apiObject.Name),
+			"release":                 flattenStringFilter(apiObject.Release),
+			"source_lambda_layer_arn": flattenStringFilter(apiObject.SourceLambdaLayerArn),
+			"source_layer_hash":       flattenStringFilter(apiObject.SourceLayerHash),
+			names.AttrVersion:         flattenStringFilter(apiObject.Version),
+		}
+
+		if v := apiObject.Epoch; v != nil {
+			tfMap["epoch"] = flattenNumberFilters([]awstypes.NumberFilter{*v})
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_inspector2_findings", name="Findings")
+func dataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter_criteria": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: filterCriteriaSchema(),
+				},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exploit_available": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fix_available": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inspector_score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"last_observed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResources: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrID: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"partition": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerability_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Inspector2Client(ctx)
+
+	input := &inspector2.ListFindingsInput{}
+
+	if v, ok := d.GetOk("filter_criteria"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.FilterCriteria = expandFilterCriteria(v.([]any)[0].(map[string]any))
+	}
+
+	findings, err := findFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Inspector2 Findings: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
+	if err := d.Set("findings", flattenFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+
+	return diags
+}
+
+func findFindings(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFindingsInput) ([]awstypes.Finding, error) {
+	var output []awstypes.Finding
+
+	pages := inspector2.NewListFindingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func flattenFindings(apiObjects []awstypes.Finding) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"aws_account_id":      aws.ToString(apiObject.AwsAccountId),
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			"exploit_available":   apiObject.ExploitAvailable,
+			"finding_arn":         aws.ToString(apiObject.FindingArn),
+			"fix_available":       apiObject.FixAvailable,
+			"inspector_score":     aws.ToFloat64(apiObject.InspectorScore),
+			names.AttrResources:   flattenFindingResources(apiObject.Resources),
+			"severity":            apiObject.Severity,
+			names.AttrStatus:      apiObject.Status,
+			"title":               aws.ToString(apiObject.Title),
+			names.AttrType:        apiObject.Type,
+		}
+
+		if v := apiObject.FirstObservedAt; v != nil {
+			tfMap["first_observed_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastObservedAt; v != nil {
+			tfMap["last_observed_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.PackageVulnerabilityDetails; v != nil {
+			tfMap["vulnerability_id"] = aws.ToString(v.VulnerabilityId)
+		}
+
+		if v := apiObject.UpdatedAt; v != nil {
+			tfMap["updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFindingResources(apiObjects []awstypes.Resource) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrID:     aws.ToString(apiObject.Id),
+			"partition":      aws.ToString(apiObject.Partition),
+			names.AttrRegion: aws.ToString(apiObject.Region),
+			names.AttrType:   apiObject.Type,
+		})
+	}
+
+	return tfList
+}
@@ -174,7 +174,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -213,7 +213,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -327,7 +327,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -338,7 +338,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -381,6 +381,7 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir":      sourceDirSchema(false),
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrTimeout: {
@@ -458,6 +459,7 @@ func resourceFunction() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			setSourceCodeHashFromSourceDir(mutexKey),
 			checkHandlerRuntimeForZipFunction,
 			updateComputedAttributesOnPublish,
 		),
@@ -495,6 +497,23 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 
 		input.Code.ZipFile = zipFile
+	} else if _, ok := d.GetOk("source_dir"); ok {
+		// Grab an exclusive lock so that we're only packaging one function into memory at a time.
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		p, err := buildSourceDirPackage(ctx, d, meta)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
+		}
+
+		if p.s3Key != "" {
+			input.Code.S3Bucket = aws.String(p.s3Bucket)
+			input.Code.S3Key = aws.String(p.s3Key)
+		} else {
+			input.Code.ZipFile = p.zipFile
+		}
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
 	} else {
@@ -961,6 +980,23 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			}
 
 			input.ZipFile = zipFile
+		} else if _, ok := d.GetOk("source_dir"); ok {
+			// Grab an exclusive lock so that we're only packaging one function into memory at a time.
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			p, err := buildSourceDirPackage(ctx, d, meta)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+			}
+
+			if p.s3Key != "" {
+				input.S3Bucket = aws.String(p.s3Bucket)
+				input.S3Key = aws.String(p.s3Key)
+			} else {
+				input.ZipFile = p.zipFile
+			}
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
 		} else {
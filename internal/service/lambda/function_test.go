@@ -1931,6 +1931,72 @@ func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	sourceDir := t.TempDir()
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCopyFile(t, "test-fixtures/lambda_func.js", filepath.Join(sourceDir, "lambda.js"))
+					testAccCopyFile(t, "test-fixtures/lambda_func.js", filepath.Join(sourceDir, "tests", "lambda_test.js"))
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_code_hash", "source_dir"},
+			},
+			{
+				// Modification times and excluded files don't change the deployment package.
+				PreConfig: func() {
+					mtime := time.Now().Add(1 * time.Hour)
+					if err := os.Chtimes(filepath.Join(sourceDir, "lambda.js"), mtime, mtime); err != nil {
+						t.Fatal(err)
+					}
+					testAccCopyFile(t, "test-fixtures/lambda_func_modified.js", filepath.Join(sourceDir, "tests", "lambda_test.js"))
+				},
+				Config:   testAccFunctionConfig_sourceDir(sourceDir, rName),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					testAccCopyFile(t, "test-fixtures/lambda_func_modified.js", filepath.Join(sourceDir, "lambda.js"))
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	path, zipFile, err := createTempFile("lambda_s3Update")
@@ -2474,6 +2540,23 @@ func createTempFile(prefix string) (string, *os.File, error) {
 	return pathToFile, f, nil
 }
 
+func testAccCopyFile(t *testing.T, source, destination string) {
+	t.Helper()
+
+	fileContent, err := os.ReadFile(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destination), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(destination, fileContent, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccFunctionConfigBase_properIAMDependencies(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -3769,6 +3852,24 @@ resource "aws_lambda_function" "test" {
 `, filePath, rName)
 }
 
+func testAccFunctionConfig_sourceDir(sourceDir, rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  function_name = %[2]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+
+  source_dir {
+    path     = %[1]q
+    excludes = ["tests"]
+  }
+}
+`, sourceDir, rName))
+}
+
 func testAccFunctionConfig_localNameOnly(filePath, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
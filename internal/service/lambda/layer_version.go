@@ -84,7 +84,7 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version"},
+				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_dir"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -105,19 +105,19 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -143,11 +143,14 @@ func resourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": sourceDirSchema(true),
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: setSourceCodeHashFromSourceDir(mutexLayerKey),
 	}
 }
 
@@ -161,8 +164,10 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	_, hasSourceDir := d.GetOk("source_dir")
+
+	if !hasFilename && !hasSourceDir && !bucketOk && !keyOk && !versionOk {
+		return sdkdiag.AppendErrorf(diags, "filename, source_dir or s3_* attributes must be set")
 	}
 
 	var layerContent *awstypes.LayerVersionContentInput
@@ -178,6 +183,25 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 		layerContent = &awstypes.LayerVersionContentInput{
 			ZipFile: file,
 		}
+	} else if hasSourceDir {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+
+		p, err := buildSourceDirPackage(ctx, d, meta)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "publishing Lambda Layer (%s) Version: %s", layerName, err)
+		}
+
+		if p.s3Key != "" {
+			layerContent = &awstypes.LayerVersionContentInput{
+				S3Bucket: aws.String(p.s3Bucket),
+				S3Key:    aws.String(p.s3Key),
+			}
+		} else {
+			layerContent = &awstypes.LayerVersionContentInput{
+				ZipFile: p.zipFile,
+			}
+		}
 	} else {
 		if !bucketOk || !keyOk {
 			return sdkdiag.AppendErrorf(diags, "s3_bucket and s3_key must all be set while using s3 code source")
@@ -6,6 +6,7 @@ package lambda_test
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
@@ -150,6 +151,51 @@ func TestAccLambdaLayerVersion_sourceCodeHash(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCopyFile(t, "test-fixtures/lambda_func.js", filepath.Join(sourceDir, "nodejs", "lambda.js"))
+				},
+				Config: testAccLayerVersionConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:1", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+			{
+				Config: testAccLayerVersionConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:1", rName)),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccCopyFile(t, "test-fixtures/lambda_func_modified.js", filepath.Join(sourceDir, "nodejs", "lambda.js"))
+				},
+				Config: testAccLayerVersionConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:2", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaLayerVersion_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lambda_layer_version.test"
@@ -453,6 +499,18 @@ resource "aws_lambda_layer_version" "test" {
 `, filename, rName)
 }
 
+func testAccLayerVersionConfig_sourceDir(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  layer_name = %[1]q
+
+  source_dir {
+    path = %[2]q
+  }
+}
+`, rName, sourceDir)
+}
+
 func testAccLayerVersionConfig_compatibleRuntimes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lambda_layer_version" "test" {
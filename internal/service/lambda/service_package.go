@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func (p *servicePackage) TagPropagations(context.Context) []*types.ServicePackageTagPropagation {
+	return []*types.ServicePackageTagPropagation{
+		{
+			TypeName:                "aws_lambda_function",
+			ChildResourceName:       "Network Interface",
+			ChildServicePackageName: names.EC2,
+			ListChildren:            listFunctionNetworkInterfaceIDs,
+		},
+	}
+}
+
+// listFunctionNetworkInterfaceIDs returns the IDs of the Hyperplane ENIs created for a function's VPC configuration.
+func listFunctionNetworkInterfaceIDs(ctx context.Context, meta any, name string) ([]string, error) {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := ec2.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			tfec2.NewFilter("interface-type", []string{string(ec2types.NetworkInterfaceTypeLambda)}),
+			tfec2.NewFilter(names.AttrDescription, []string{"AWS Lambda VPC ENI-" + name + "*"}),
+		},
+	}
+	output, err := tfec2.FindNetworkInterfaces(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v ec2types.NetworkInterface) string {
+		return aws.ToString(v.NetworkInterfaceId)
+	}), nil
+}
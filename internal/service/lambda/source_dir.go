@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum size of a deployment package that can be uploaded directly to Lambda.
+	// Larger packages must be uploaded to S3.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	zipFileMaxSize = 50 * 1024 * 1024
+)
+
+func sourceDirSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"excludes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: forceNew,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"includes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: forceNew,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrPath: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: forceNew,
+				},
+				names.AttrS3Bucket: {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: forceNew,
+				},
+				"s3_key_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: forceNew,
+				},
+			},
+		},
+	}
+}
+
+type sourceDir struct {
+	path        string
+	includes    []string
+	excludes    []string
+	s3Bucket    string
+	s3KeyPrefix string
+}
+
+func expandSourceDir(tfList []any) *sourceDir {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+	apiObject := &sourceDir{
+		path: tfMap[names.AttrPath].(string),
+	}
+
+	if v, ok := tfMap["excludes"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.excludes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["includes"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.includes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap[names.AttrS3Bucket].(string); ok {
+		apiObject.s3Bucket = v
+	}
+
+	if v, ok := tfMap["s3_key_prefix"].(string); ok {
+		apiObject.s3KeyPrefix = v
+	}
+
+	return apiObject
+}
+
+// sourceDirPackage is a deployment package built from a source directory.
+type sourceDirPackage struct {
+	zipFile []byte
+	// Base64-encoded SHA256 hash of the ZIP file, as returned by Lambda's CodeSha256.
+	sourceCodeHash string
+	// S3 location of the ZIP file, if uploaded to S3.
+	s3Bucket string
+	s3Key    string
+}
+
+// build creates a reproducible ZIP archive of the source directory.
+func (sd *sourceDir) build() (*sourceDirPackage, error) {
+	zipFile, err := tfio.ZipDirectory(sd.path, sd.includes, sd.excludes)
+
+	if err != nil {
+		return nil, fmt.Errorf("packaging source directory (%s): %w", sd.path, err)
+	}
+
+	hash := sha256.Sum256(zipFile)
+
+	return &sourceDirPackage{
+		zipFile:        zipFile,
+		sourceCodeHash: base64.StdEncoding.EncodeToString(hash[:]),
+	}, nil
+}
+
+// upload uploads the deployment package to S3 if it's too large to be uploaded directly to Lambda.
+// The object key is derived from the package's contents, so unchanged packages are not re-uploaded under a new key.
+func (p *sourceDirPackage) upload(ctx context.Context, meta any, sd *sourceDir) error {
+	if len(p.zipFile) <= zipFileMaxSize {
+		return nil
+	}
+
+	if sd.s3Bucket == "" {
+		return fmt.Errorf("deployment package size (%d bytes) exceeds the direct upload limit (%d bytes) and source_dir.s3_bucket is not set", len(p.zipFile), zipFileMaxSize)
+	}
+
+	hash := sha256.Sum256(p.zipFile)
+	key := sd.s3KeyPrefix + hex.EncodeToString(hash[:]) + ".zip"
+
+	input := &s3.PutObjectInput{
+		Body:   bytes.NewReader(p.zipFile),
+		Bucket: aws.String(sd.s3Bucket),
+		Key:    aws.String(key),
+	}
+
+	uploader := manager.NewUploader(meta.(*conns.AWSClient).S3Client(ctx))
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading deployment package to S3 Bucket (%s) Object (%s): %w", sd.s3Bucket, key, err)
+	}
+
+	p.s3Bucket, p.s3Key = sd.s3Bucket, key
+
+	return nil
+}
+
+// buildSourceDirPackage builds the deployment package for the configured source directory and checks
+// that the source directory hasn't changed since plan time.
+func buildSourceDirPackage(ctx context.Context, d *schema.ResourceData, meta any) (*sourceDirPackage, error) {
+	sd := expandSourceDir(d.Get("source_dir").([]any))
+
+	p, err := sd.build()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v := d.Get("source_code_hash").(string); v != "" && v != p.sourceCodeHash {
+		return nil, fmt.Errorf("source directory (%s) changed after plan: source_code_hash is %s, planned %s", sd.path, p.sourceCodeHash, v)
+	}
+
+	if err := p.upload(ctx, meta, sd); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// setSourceCodeHashFromSourceDir sets source_code_hash at plan time to the hash of the deployment package built
+// from the configured source directory, so that a diff is only shown when the directory's contents change.
+func setSourceCodeHashFromSourceDir(mutexKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+		for _, key := range []string{"source_dir.0.excludes", "source_dir.0.includes", "source_dir.0.path"} {
+			if !d.NewValueKnown(key) {
+				return d.SetNewComputed("source_code_hash")
+			}
+		}
+
+		sd := expandSourceDir(d.Get("source_dir").([]any))
+		if sd == nil || sd.path == "" {
+			return nil
+		}
+
+		// Grab an exclusive lock so that we're only packaging one source directory into memory at a time.
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		p, err := sd.build()
+
+		if err != nil {
+			return err
+		}
+
+		if o, _ := d.GetChange("source_code_hash"); o.(string) != p.sourceCodeHash {
+			return d.SetNew("source_code_hash", p.sourceCodeHash)
+		}
+
+		return nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfelasticache "github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -51,6 +53,8 @@ func resourceCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
 
+		CustomizeDiff: clusterCustomizeDiffEngineUpgrade,
+
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				"acl_name": {
@@ -83,6 +87,11 @@ func resourceCluster() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"engine_upgrade_steps": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
 				"multi_region_cluster_name": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -268,6 +277,61 @@ func resourceCluster() *schema.Resource {
 	}
 }
 
+// clusterCustomizeDiffEngineUpgrade validates in-place `engine` and `engine_version` changes against the supported
+// upgrade paths and the engine versions available in the Region, and previews the upgrade in `engine_upgrade_steps`.
+func clusterCustomizeDiffEngineUpgrade(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if diff.Id() == "" || !diff.HasChanges(names.AttrEngine, names.AttrEngineVersion) {
+		return nil
+	}
+
+	if !diff.NewValueKnown(names.AttrEngine) || !diff.NewValueKnown(names.AttrEngineVersion) {
+		return nil
+	}
+
+	oldVersion := diff.Get("engine_patch_version").(string)
+	if oldVersion == "" {
+		o, _ := diff.GetChange(names.AttrEngineVersion)
+		oldVersion = o.(string)
+	}
+	if oldVersion == "" {
+		return nil
+	}
+
+	var newVersion string
+	if diff.HasChange(names.AttrEngineVersion) {
+		newVersion = diff.Get(names.AttrEngineVersion).(string)
+	}
+
+	o, n := diff.GetChange(names.AttrEngine)
+	// MemoryDB can upgrade any supported Redis OSS version directly to Valkey.
+	steps, err := tfelasticache.PlanEngineUpgrade(o.(string), oldVersion, n.(string), newVersion, "")
+	if err != nil {
+		return err
+	}
+
+	if len(steps) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).MemoryDBClient(ctx)
+
+	for _, step := range steps {
+		versions, err := findEngineVersionsByEngine(ctx, conn, step.Engine)
+
+		if err != nil {
+			return fmt.Errorf("reading MemoryDB %s engine versions: %w", step.Engine, err)
+		}
+
+		if !slices.ContainsFunc(versions, func(v awstypes.EngineVersionInfo) bool {
+			return tfelasticache.EngineVersionMatches(aws.ToString(v.EngineVersion), step.EngineVersion)
+		}) {
+			return fmt.Errorf("MemoryDB %s engine version %s is not available in this Region", step.Engine, step.EngineVersion)
+		}
+	}
+
+	return diff.SetNew("engine_upgrade_steps", tfslices.ApplyToAll(steps, tfelasticache.EngineUpgradeStep.String))
+}
+
 func endpointSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -424,6 +488,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	d.Set(names.AttrDescription, cluster.Description)
 	d.Set("engine_patch_version", cluster.EnginePatchVersion)
+	d.Set("engine_upgrade_steps", nil)
 	d.Set("multi_region_cluster_name", cluster.MultiRegionClusterName)
 	d.Set(names.AttrEngine, cluster.Engine)
 	d.Set(names.AttrEngineVersion, cluster.EngineVersion)
@@ -647,6 +712,26 @@ func findClusters(ctx context.Context, conn *memorydb.Client, input *memorydb.De
 	return output, nil
 }
 
+func findEngineVersionsByEngine(ctx context.Context, conn *memorydb.Client, engine string) ([]awstypes.EngineVersionInfo, error) {
+	input := &memorydb.DescribeEngineVersionsInput{
+		Engine: aws.String(engine),
+	}
+	var output []awstypes.EngineVersionInfo
+
+	pages := memorydb.NewDescribeEngineVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EngineVersions...)
+	}
+
+	return output, nil
+}
+
 func statusCluster(ctx context.Context, conn *memorydb.Client, name string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findClusterByName(ctx, conn, name)
@@ -47,7 +47,7 @@ func resourceFirewall() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			customdiff.ComputedIf("firewall_status", func(ctx context.Context, diff *schema.ResourceDiff, meta any) bool {
-				return diff.HasChange("subnet_mapping")
+				return diff.HasChanges("availability_zone_mapping", "subnet_mapping")
 			}),
 		),
 
@@ -57,6 +57,23 @@ func resourceFirewall() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"availability_zone_change_protection": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"availability_zone_mapping": {
+					Type:         schema.TypeSet,
+					Optional:     true,
+					RequiredWith: []string{names.AttrTransitGatewayID},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"availability_zone_id": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
 				"delete_protection": {
 					Type:     schema.TypeBool,
 					Optional: true,
@@ -109,6 +126,18 @@ func resourceFirewall() *schema.Resource {
 									},
 								},
 							},
+							"transit_gateway_attachment_sync_states": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"attachment_id": {
+											Type:     schema.TypeString,
+											Computed: true,
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -122,8 +151,9 @@ func resourceFirewall() *schema.Resource {
 					Optional: true,
 				},
 				"subnet_mapping": {
-					Type:     schema.TypeSet,
-					Required: true,
+					Type:         schema.TypeSet,
+					Optional:     true,
+					ExactlyOneOf: []string{"availability_zone_mapping", "subnet_mapping"},
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							names.AttrIPAddressType: {
@@ -141,13 +171,23 @@ func resourceFirewall() *schema.Resource {
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				names.AttrTransitGatewayID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ExactlyOneOf: []string{names.AttrTransitGatewayID, names.AttrVPCID},
+				},
+				"transit_gateway_owner_account_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				"update_token": {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
 				names.AttrVPCID: {
 					Type:     schema.TypeString,
-					Required: true,
+					Optional: true,
 					ForceNew: true,
 				},
 			}
@@ -163,9 +203,15 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, meta an
 	input := &networkfirewall.CreateFirewallInput{
 		FirewallName:      aws.String(name),
 		FirewallPolicyArn: aws.String(d.Get("firewall_policy_arn").(string)),
-		SubnetMappings:    expandSubnetMappings(d.Get("subnet_mapping").(*schema.Set).List()),
 		Tags:              getTagsIn(ctx),
-		VpcId:             aws.String(d.Get(names.AttrVPCID).(string)),
+	}
+
+	if v, ok := d.GetOk("availability_zone_change_protection"); ok {
+		input.AvailabilityZoneChangeProtection = v.(bool)
+	}
+
+	if v, ok := d.GetOk("availability_zone_mapping"); ok && v.(*schema.Set).Len() > 0 {
+		input.AvailabilityZoneMappings = expandAvailabilityZoneMappings(v.(*schema.Set).List())
 	}
 
 	if v, ok := d.GetOk("delete_protection"); ok {
@@ -188,6 +234,18 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, meta an
 		input.SubnetChangeProtection = v.(bool)
 	}
 
+	if v, ok := d.GetOk("subnet_mapping"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetMappings = expandSubnetMappings(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk(names.AttrTransitGatewayID); ok {
+		input.TransitGatewayId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrVPCID); ok {
+		input.VpcId = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateFirewall(ctx, input)
 
 	if err != nil {
@@ -221,6 +279,10 @@ func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, meta any)
 
 	firewall := output.Firewall
 	d.Set(names.AttrARN, firewall.FirewallArn)
+	d.Set("availability_zone_change_protection", firewall.AvailabilityZoneChangeProtection)
+	if err := d.Set("availability_zone_mapping", flattenAvailabilityZoneMappings(firewall.AvailabilityZoneMappings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting availability_zone_mapping: %s", err)
+	}
 	d.Set("delete_protection", firewall.DeleteProtection)
 	d.Set(names.AttrDescription, firewall.Description)
 	if err := d.Set(names.AttrEncryptionConfiguration, flattenEncryptionConfiguration(firewall.EncryptionConfiguration)); err != nil {
@@ -236,6 +298,8 @@ func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, meta any)
 	if err := d.Set("subnet_mapping", flattenSubnetMappings(firewall.SubnetMappings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting subnet_mapping: %s", err)
 	}
+	d.Set(names.AttrTransitGatewayID, firewall.TransitGatewayId)
+	d.Set("transit_gateway_owner_account_id", firewall.TransitGatewayOwnerAccountId)
 	d.Set("update_token", output.UpdateToken)
 	d.Set(names.AttrVPCID, firewall.VpcId)
 
@@ -333,6 +397,71 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		updateToken = aws.ToString(output.UpdateToken)
 	}
 
+	if d.HasChange("availability_zone_change_protection") {
+		input := &networkfirewall.UpdateAvailabilityZoneChangeProtectionInput{
+			AvailabilityZoneChangeProtection: d.Get("availability_zone_change_protection").(bool),
+			FirewallArn:                      aws.String(d.Id()),
+			UpdateToken:                      aws.String(updateToken),
+		}
+
+		output, err := conn.UpdateAvailabilityZoneChangeProtection(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Firewall (%s) availability zone change protection: %s", d.Id(), err)
+		}
+
+		updateToken = aws.ToString(output.UpdateToken)
+	}
+
+	if d.HasChange("availability_zone_mapping") {
+		o, n := d.GetChange("availability_zone_mapping")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+		if add := newSet.Difference(oldSet); add.Len() > 0 {
+			input := &networkfirewall.AssociateAvailabilityZonesInput{
+				AvailabilityZoneMappings: expandAvailabilityZoneMappings(add.List()),
+				FirewallArn:              aws.String(d.Id()),
+				UpdateToken:              aws.String(updateToken),
+			}
+
+			_, err := conn.AssociateAvailabilityZones(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating NetworkFirewall Firewall (%s) availability zones: %s", d.Id(), err)
+			}
+
+			output, err := waitFirewallUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
+			}
+
+			updateToken = aws.ToString(output.UpdateToken)
+		}
+
+		if del := oldSet.Difference(newSet); del.Len() > 0 {
+			input := &networkfirewall.DisassociateAvailabilityZonesInput{
+				AvailabilityZoneMappings: expandAvailabilityZoneMappings(del.List()),
+				FirewallArn:              aws.String(d.Id()),
+				UpdateToken:              aws.String(updateToken),
+			}
+
+			_, err := conn.DisassociateAvailabilityZones(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating NetworkFirewall Firewall (%s) availability zones: %s", d.Id(), err)
+			}
+
+			output, err := waitFirewallUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
+			}
+
+			updateToken = aws.ToString(output.UpdateToken)
+		}
+	}
+
 	if d.HasChange("subnet_change_protection") {
 		input := &networkfirewall.UpdateSubnetChangeProtectionInput{
 			FirewallArn:            aws.String(d.Id()),
@@ -548,6 +677,25 @@ func expandSubnetMappings(tfList []any) []awstypes.SubnetMapping {
 	return apiObjects
 }
 
+func expandAvailabilityZoneMappings(tfList []any) []awstypes.AvailabilityZoneMapping {
+	apiObjects := make([]awstypes.AvailabilityZoneMapping, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.AvailabilityZoneMapping{
+			AvailabilityZone: aws.String(tfMap["availability_zone_id"].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func expandSubnetMappingIDs(tfList []any) []string {
 	var ids []string
 
@@ -574,6 +722,12 @@ func flattenFirewallStatus(apiObject *awstypes.FirewallStatus) []any {
 		"sync_states": flattenSyncStates(apiObject.SyncStates),
 	}
 
+	if v := apiObject.TransitGatewayAttachmentSyncState; v != nil {
+		tfMap["transit_gateway_attachment_sync_states"] = []any{map[string]any{
+			"attachment_id": aws.ToString(v.AttachmentId),
+		}}
+	}
+
 	return []any{tfMap}
 }
 
@@ -609,6 +763,20 @@ func flattenAttachment(apiObject *awstypes.Attachment) []any {
 	return []any{tfMap}
 }
 
+func flattenAvailabilityZoneMappings(apiObjects []awstypes.AvailabilityZoneMapping) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"availability_zone_id": aws.ToString(apiObject.AvailabilityZone),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenSubnetMappings(apiObjects []awstypes.SubnetMapping) []any {
 	tfList := make([]any, 0, len(apiObjects))
 
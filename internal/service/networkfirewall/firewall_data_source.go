@@ -34,6 +34,22 @@ func dataSourceFirewall() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 				AtLeastOneOf: []string{names.AttrARN, names.AttrName},
 			},
+			"availability_zone_change_protection": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"availability_zone_mapping": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"delete_protection": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -147,6 +163,26 @@ func dataSourceFirewall() *schema.Resource {
 								},
 							},
 						},
+						"transit_gateway_attachment_sync_states": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attachment_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrStatusMessage: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"transit_gateway_attachment_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -174,6 +210,14 @@ func dataSourceFirewall() *schema.Resource {
 				},
 			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrTransitGatewayID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"update_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -207,6 +251,10 @@ func dataSourceFirewallResourceRead(ctx context.Context, d *schema.ResourceData,
 	firewall := output.Firewall
 	d.SetId(aws.ToString(firewall.FirewallArn))
 	d.Set(names.AttrARN, firewall.FirewallArn)
+	d.Set("availability_zone_change_protection", firewall.AvailabilityZoneChangeProtection)
+	if err := d.Set("availability_zone_mapping", flattenAvailabilityZoneMappings(firewall.AvailabilityZoneMappings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting availability_zone_mapping: %s", err)
+	}
 	d.Set("delete_protection", firewall.DeleteProtection)
 	d.Set(names.AttrDescription, firewall.Description)
 	if err := d.Set(names.AttrEncryptionConfiguration, flattenDataSourceEncryptionConfiguration(firewall.EncryptionConfiguration)); err != nil {
@@ -222,6 +270,8 @@ func dataSourceFirewallResourceRead(ctx context.Context, d *schema.ResourceData,
 	if err := d.Set("subnet_mapping", flattenDataSourceSubnetMappings(firewall.SubnetMappings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting subnet_mappings: %s", err)
 	}
+	d.Set(names.AttrTransitGatewayID, firewall.TransitGatewayId)
+	d.Set("transit_gateway_owner_account_id", firewall.TransitGatewayOwnerAccountId)
 	d.Set("update_token", output.UpdateToken)
 	d.Set(names.AttrVPCID, firewall.VpcId)
 
@@ -246,6 +296,13 @@ func flattenDataSourceFirewallStatus(apiObject *awstypes.FirewallStatus) []any {
 	if apiObject.SyncStates != nil {
 		tfMap["sync_states"] = flattenDataSourceSyncStates(apiObject.SyncStates)
 	}
+	if v := apiObject.TransitGatewayAttachmentSyncState; v != nil {
+		tfMap["transit_gateway_attachment_sync_states"] = []any{map[string]any{
+			"attachment_id":                     aws.ToString(v.AttachmentId),
+			names.AttrStatusMessage:             aws.ToString(v.StatusMessage),
+			"transit_gateway_attachment_status": v.TransitGatewayAttachmentStatus,
+		}}
+	}
 
 	return []any{tfMap}
 }
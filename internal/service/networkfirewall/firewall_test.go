@@ -404,6 +404,55 @@ func TestAccNetworkFirewallFirewall_tags(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallFirewall_transitGateway(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallConfig_transitGateway(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone_change_protection", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.transit_gateway_attachment_sync_states.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "firewall_status.0.transit_gateway_attachment_sync_states.0.attachment_id"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_mapping.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTransitGatewayID, transitGatewayResourceName, names.AttrID),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, "transit_gateway_owner_account_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVPCID, ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFirewallConfig_transitGateway(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone_mapping.#", "2"),
+				),
+			},
+			{
+				Config: testAccFirewallConfig_transitGateway(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone_mapping.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallFirewall_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -687,3 +736,36 @@ resource "aws_networkfirewall_firewall" "test" {
 }
 `, rName))
 }
+
+func testAccFirewallConfig_transitGateway(rName string, azCount int) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+  }
+}
+
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %[1]q
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  transit_gateway_id  = aws_ec2_transit_gateway.test.id
+
+  dynamic "availability_zone_mapping" {
+    for_each = slice(data.aws_availability_zones.available.zone_ids, 0, %[2]d)
+
+    content {
+      availability_zone_id = availability_zone_mapping.value
+    }
+  }
+}
+`, rName, azCount))
+}
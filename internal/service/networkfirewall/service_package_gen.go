@@ -15,7 +15,14 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newVPCEndpointAssociationDataSource,
+			TypeName: "aws_networkfirewall_vpc_endpoint_association",
+			Name:     "VPC Endpoint Association",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -28,6 +35,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newVPCEndpointAssociationResource,
+			TypeName: "aws_networkfirewall_vpc_endpoint_association",
+			Name:     "VPC Endpoint Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "vpc_endpoint_association_arn",
+			},
+		},
 	}
 }
 
@@ -0,0 +1,407 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/networkfirewall"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/networkfirewall/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_networkfirewall_vpc_endpoint_association", name="VPC Endpoint Association")
+// @Tags(identifierAttribute="vpc_endpoint_association_arn")
+func newVPCEndpointAssociationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &vpcEndpointAssociationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type vpcEndpointAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[vpcEndpointAssociationResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *vpcEndpointAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"firewall_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:                 tftags.TagsAttribute(),
+			names.AttrTagsAll:              tftags.TagsAttributeComputedOnly(),
+			"vpc_endpoint_association_arn": framework.ARNAttributeComputedOnly(),
+			"vpc_endpoint_association_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpc_endpoint_association_status": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcEndpointAssociationStatusModel](ctx),
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[vpcEndpointAssociationStatusModel](ctx),
+				},
+			},
+			names.AttrVPCID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"subnet_mapping": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subnetMappingModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrIPAddressType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.IPAddressType](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrSubnetID: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vpcEndpointAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data vpcEndpointAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NetworkFirewallClient(ctx)
+
+	var input networkfirewall.CreateVpcEndpointAssociationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateVpcEndpointAssociation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating NetworkFirewall VPC Endpoint Association (%s)", data.FirewallARN.ValueString()), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.VpcEndpointAssociation.VpcEndpointAssociationArn)
+	association, err := waitVPCEndpointAssociationCreated(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("vpc_endpoint_association_arn"), arn) // Set 'vpc_endpoint_association_arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for NetworkFirewall VPC Endpoint Association (%s) create", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(flattenDescribeVPCEndpointAssociationOutput(ctx, association, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *vpcEndpointAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data vpcEndpointAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NetworkFirewallClient(ctx)
+
+	arn := data.VPCEndpointAssociationARN.ValueString()
+	output, err := findVPCEndpointAssociationByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading NetworkFirewall VPC Endpoint Association (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenDescribeVPCEndpointAssociationOutput(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.VpcEndpointAssociation.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vpcEndpointAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data vpcEndpointAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NetworkFirewallClient(ctx)
+
+	arn := data.VPCEndpointAssociationARN.ValueString()
+	input := networkfirewall.DeleteVpcEndpointAssociationInput{
+		VpcEndpointAssociationArn: aws.String(arn),
+	}
+	_, err := conn.DeleteVpcEndpointAssociation(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting NetworkFirewall VPC Endpoint Association (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitVPCEndpointAssociationDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for NetworkFirewall VPC Endpoint Association (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *vpcEndpointAssociationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("vpc_endpoint_association_arn"), request, response)
+}
+
+func findVPCEndpointAssociationByARN(ctx context.Context, conn *networkfirewall.Client, arn string) (*networkfirewall.DescribeVpcEndpointAssociationOutput, error) {
+	input := networkfirewall.DescribeVpcEndpointAssociationInput{
+		VpcEndpointAssociationArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeVpcEndpointAssociation(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VpcEndpointAssociation == nil || output.VpcEndpointAssociationStatus == nil {
+		return nil, tfresource.NewEmptyResultError(&input)
+	}
+
+	return output, nil
+}
+
+func statusVPCEndpointAssociation(ctx context.Context, conn *networkfirewall.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findVPCEndpointAssociationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.VpcEndpointAssociationStatus.Status), nil
+	}
+}
+
+func waitVPCEndpointAssociationCreated(ctx context.Context, conn *networkfirewall.Client, arn string, timeout time.Duration) (*networkfirewall.DescribeVpcEndpointAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FirewallStatusValueProvisioning),
+		Target:  enum.Slice(awstypes.FirewallStatusValueReady),
+		Refresh: statusVPCEndpointAssociation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkfirewall.DescribeVpcEndpointAssociationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointAssociationDeleted(ctx context.Context, conn *networkfirewall.Client, arn string, timeout time.Duration) (*networkfirewall.DescribeVpcEndpointAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FirewallStatusValueDeleting),
+		Target:  []string{},
+		Refresh: statusVPCEndpointAssociation(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkfirewall.DescribeVpcEndpointAssociationOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenDescribeVPCEndpointAssociationOutput(ctx context.Context, apiObject *networkfirewall.DescribeVpcEndpointAssociationOutput, data *vpcEndpointAssociationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject.VpcEndpointAssociation, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	status, d := flattenVPCEndpointAssociationStatus(ctx, apiObject.VpcEndpointAssociationStatus)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.VPCEndpointAssociationStatus = status
+
+	return diags
+}
+
+// flattenVPCEndpointAssociationStatus flattens the per-Availability Zone sync states.
+// The API returns them as a map keyed by Availability Zone, which AutoFlex can't flatten into a set of objects.
+func flattenVPCEndpointAssociationStatus(ctx context.Context, apiObject *awstypes.VpcEndpointAssociationStatus) (fwtypes.ListNestedObjectValueOf[vpcEndpointAssociationStatusModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[vpcEndpointAssociationStatusModel](ctx), diags
+	}
+
+	syncStates := make([]*associationSyncStateModel, 0, len(apiObject.AssociationSyncState))
+	for az, v := range apiObject.AssociationSyncState {
+		var attachment attachmentModel
+		if v.Attachment != nil {
+			diags.Append(fwflex.Flatten(ctx, v.Attachment, &attachment)...)
+			if diags.HasError() {
+				return fwtypes.NewListNestedObjectValueOfNull[vpcEndpointAssociationStatusModel](ctx), diags
+			}
+		}
+
+		syncStates = append(syncStates, &associationSyncStateModel{
+			Attachment:       fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &attachment),
+			AvailabilityZone: types.StringValue(az),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &vpcEndpointAssociationStatusModel{
+		AssociationSyncStates: fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, syncStates),
+	}), diags
+}
+
+type vpcEndpointAssociationResourceModel struct {
+	Description                  types.String                                                       `tfsdk:"description"`
+	FirewallARN                  fwtypes.ARN                                                        `tfsdk:"firewall_arn"`
+	SubnetMapping                fwtypes.ListNestedObjectValueOf[subnetMappingModel]                `tfsdk:"subnet_mapping"`
+	Tags                         tftags.Map                                                         `tfsdk:"tags"`
+	TagsAll                      tftags.Map                                                         `tfsdk:"tags_all"`
+	Timeouts                     timeouts.Value                                                     `tfsdk:"timeouts"`
+	VPCEndpointAssociationARN    types.String                                                       `tfsdk:"vpc_endpoint_association_arn"`
+	VPCEndpointAssociationID     types.String                                                       `tfsdk:"vpc_endpoint_association_id"`
+	VPCEndpointAssociationStatus fwtypes.ListNestedObjectValueOf[vpcEndpointAssociationStatusModel] `tfsdk:"vpc_endpoint_association_status"`
+	VPCID                        types.String                                                       `tfsdk:"vpc_id"`
+}
+
+type subnetMappingModel struct {
+	IPAddressType fwtypes.StringEnum[awstypes.IPAddressType] `tfsdk:"ip_address_type"`
+	SubnetID      types.String                               `tfsdk:"subnet_id"`
+}
+
+type vpcEndpointAssociationStatusModel struct {
+	AssociationSyncStates fwtypes.SetNestedObjectValueOf[associationSyncStateModel] `tfsdk:"association_sync_state"`
+}
+
+type associationSyncStateModel struct {
+	Attachment       fwtypes.ListNestedObjectValueOf[attachmentModel] `tfsdk:"attachment"`
+	AvailabilityZone types.String                                     `tfsdk:"availability_zone"`
+}
+
+type attachmentModel struct {
+	EndpointID    types.String `tfsdk:"endpoint_id"`
+	Status        types.String `tfsdk:"status"`
+	StatusMessage types.String `tfsdk:"status_message"`
+	SubnetID      types.String `tfsdk:"subnet_id"`
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_networkfirewall_vpc_endpoint_association", name="VPC Endpoint Association")
+// @Tags
+// @Testing(tagsTest=false)
+func newVPCEndpointAssociationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &vpcEndpointAssociationDataSource{}, nil
+}
+
+type vpcEndpointAssociationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *vpcEndpointAssociationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"firewall_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Computed:   true,
+			},
+			"subnet_mapping": framework.DataSourceComputedListOfObjectAttribute[subnetMappingModel](ctx),
+			names.AttrTags:   tftags.TagsAttributeComputedOnly(),
+			"vpc_endpoint_association_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"vpc_endpoint_association_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"vpc_endpoint_association_status": framework.DataSourceComputedListOfObjectAttribute[vpcEndpointAssociationStatusModel](ctx),
+			names.AttrVPCID: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *vpcEndpointAssociationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data vpcEndpointAssociationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().NetworkFirewallClient(ctx)
+
+	output, err := findVPCEndpointAssociationByARN(ctx, conn, data.VPCEndpointAssociationARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("reading NetworkFirewall VPC Endpoint Association", tfresource.SingularDataSourceFindError("NetworkFirewall VPC Endpoint Association", err).Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.VpcEndpointAssociation, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	status, diags := flattenVPCEndpointAssociationStatus(ctx, output.VpcEndpointAssociationStatus)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.VPCEndpointAssociationStatus = status
+
+	setTagsOut(ctx, output.VpcEndpointAssociation.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type vpcEndpointAssociationDataSourceModel struct {
+	Description                  types.String                                                       `tfsdk:"description"`
+	FirewallARN                  fwtypes.ARN                                                        `tfsdk:"firewall_arn"`
+	SubnetMapping                fwtypes.ListNestedObjectValueOf[subnetMappingModel]                `tfsdk:"subnet_mapping"`
+	Tags                         tftags.Map                                                         `tfsdk:"tags"`
+	VPCEndpointAssociationARN    fwtypes.ARN                                                        `tfsdk:"vpc_endpoint_association_arn"`
+	VPCEndpointAssociationID     types.String                                                       `tfsdk:"vpc_endpoint_association_id"`
+	VPCEndpointAssociationStatus fwtypes.ListNestedObjectValueOf[vpcEndpointAssociationStatusModel] `tfsdk:"vpc_endpoint_association_status"`
+	VPCID                        types.String                                                       `tfsdk:"vpc_id"`
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallVPCEndpointAssociationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_networkfirewall_vpc_endpoint_association.test"
+	resourceName := "aws_networkfirewall_vpc_endpoint_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointAssociationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "firewall_arn", resourceName, "firewall_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnet_mapping.#", resourceName, "subnet_mapping.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subnet_mapping.0.subnet_id", resourceName, "subnet_mapping.0.subnet_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_endpoint_association_arn", resourceName, "vpc_endpoint_association_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_endpoint_association_id", resourceName, "vpc_endpoint_association_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_endpoint_association_status.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_endpoint_association_status.0.association_sync_state.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "vpc_endpoint_association_status.0.association_sync_state.*.availability_zone", "aws_subnet.target", names.AttrAvailabilityZone),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vpc_endpoint_association_status.0.association_sync_state.0.attachment.0.endpoint_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVPCID, resourceName, names.AttrVPCID),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCEndpointAssociationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointAssociationConfig_full(rName, acctest.CtKey1, acctest.CtValue1), `
+data "aws_networkfirewall_vpc_endpoint_association" "test" {
+  vpc_endpoint_association_arn = aws_networkfirewall_vpc_endpoint_association.test.vpc_endpoint_association_arn
+}
+`)
+}
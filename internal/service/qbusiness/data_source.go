@@ -0,0 +1,667 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_data_source", name="Data Source")
+// @Tags(identifierAttribute="arn")
+func newResourceDataSource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDataSource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameDataSource = "Data Source"
+
+	dataSourceResourceIDPartCount = 3
+)
+
+type resourceDataSource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceDataSource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Description: "The identifier of the Amazon Q application the data source will be attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrConfiguration: schema.StringAttribute{
+				CustomType:  fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+				Description: "Configuration information to connect to your data source repository, as a JSON document.",
+				Required:    true,
+			},
+			"data_source_id": framework.IDAttribute(),
+			names.AttrDescription: schema.StringAttribute{
+				Description: "A description of the data source.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "A name for the data source connector.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`), "must begin with a letter or number and contain only alphanumeric, underscore, or hyphen characters"),
+				},
+			},
+			"iam_service_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The ARN of an IAM role with permission to access the data source and required resources.",
+				Optional:    true,
+			},
+			"index_id": schema.StringAttribute{
+				Description: "The identifier of the index that you want to use with the data source connector.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sync_schedule": schema.StringAttribute{
+				Description: "Sets the frequency for Amazon Q to check the documents in your data source repository and update the index, as a cron expression.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(998),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				Description: "The type of the data source connector.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"document_enrichment_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[documentEnrichmentConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"inline_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[inlineDocumentEnrichmentConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(100),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"document_content_operator": schema.StringAttribute{
+										CustomType:  fwtypes.StringEnumType[awstypes.DocumentContentOperator](),
+										Description: "Specifies whether to delete the document content.",
+										Optional:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrCondition: documentAttributeConditionBlock(ctx),
+									names.AttrTarget: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[documentAttributeTargetData](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"attribute_value_operator": schema.StringAttribute{
+													CustomType:  fwtypes.StringEnumType[awstypes.AttributeValueOperator](),
+													Description: "Specifies whether to delete the target document attribute value.",
+													Optional:    true,
+												},
+												names.AttrKey: schema.StringAttribute{
+													Description: "The identifier of the target document attribute or metadata field.",
+													Required:    true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												names.AttrValue: documentAttributeValueBlock(ctx),
+											},
+										},
+									},
+								},
+							},
+						},
+						"post_extraction_hook_configuration": hookConfigurationBlock(ctx),
+						"pre_extraction_hook_configuration":  hookConfigurationBlock(ctx),
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			names.AttrVPCConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceVPCConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "A list of identifiers of security groups within your Amazon VPC.",
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 10),
+							},
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "A list of identifiers for subnets within your Amazon VPC.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func documentAttributeConditionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[documentAttributeConditionData](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrKey: schema.StringAttribute{
+					Description: "The identifier of the document attribute used for the condition.",
+					Required:    true,
+				},
+				"operator": schema.StringAttribute{
+					CustomType:  fwtypes.StringEnumType[awstypes.DocumentEnrichmentConditionOperator](),
+					Description: "The operator used to compare the document attribute with the condition value.",
+					Required:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				names.AttrValue: documentAttributeValueBlock(ctx),
+			},
+		},
+	}
+}
+
+func documentAttributeValueBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[documentAttributeValueData](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"date_value": schema.StringAttribute{
+					CustomType:  timetypes.RFC3339Type{},
+					Description: "A date expressed as an RFC 3339 timestamp.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("date_value"),
+							path.MatchRelative().AtParent().AtName("long_value"),
+							path.MatchRelative().AtParent().AtName("string_list_value"),
+							path.MatchRelative().AtParent().AtName("string_value"),
+						),
+					},
+				},
+				"long_value": schema.Int64Attribute{
+					Description: "A long integer value.",
+					Optional:    true,
+				},
+				"string_list_value": schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Description: "A list of strings.",
+					Optional:    true,
+				},
+				"string_value": schema.StringAttribute{
+					Description: "A string.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+func hookConfigurationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[hookConfigurationData](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"lambda_arn": schema.StringAttribute{
+					CustomType:  fwtypes.ARNType,
+					Description: "The ARN of the Lambda function invoked during ingestion.",
+					Optional:    true,
+				},
+				names.AttrRoleARN: schema.StringAttribute{
+					CustomType:  fwtypes.ARNType,
+					Description: "The ARN of a role with permission to run the Lambda function.",
+					Optional:    true,
+				},
+				names.AttrS3BucketName: schema.StringAttribute{
+					Description: "Stores the original, raw documents or the structured, parsed documents before and after altering them.",
+					Optional:    true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"invocation_condition": documentAttributeConditionBlock(ctx),
+			},
+		},
+	}
+}
+
+func (r *resourceDataSource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceDataSourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateDataSourceInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateDataSource(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameDataSource, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	applicationID, indexID, dataSourceID := data.ApplicationId.ValueString(), data.IndexId.ValueString(), aws.ToString(out.DataSourceId)
+	resp.State.SetAttribute(ctx, path.Root(names.AttrApplicationID), applicationID)
+	resp.State.SetAttribute(ctx, path.Root("index_id"), indexID)
+	resp.State.SetAttribute(ctx, path.Root("data_source_id"), dataSourceID)
+
+	findOut, err := waitDataSourceActive(ctx, conn, applicationID, indexID, dataSourceID, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNameDataSource, dataSourceID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set unknown values
+	data.DataSourceArn = fwflex.StringToFramework(ctx, findOut.DataSourceArn)
+	data.DataSourceId = fwflex.StringToFramework(ctx, findOut.DataSourceId)
+	data.Type = fwflex.StringToFramework(ctx, findOut.Type)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataSource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceDataSourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	out, err := findDataSourceByThreePartKey(ctx, conn, data.ApplicationId.ValueString(), data.IndexId.ValueString(), data.DataSourceId.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameDataSource, data.DataSourceId.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *resourceDataSource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan resourceDataSourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Configuration.Equal(plan.Configuration) ||
+		!state.Description.Equal(plan.Description) ||
+		!state.DisplayName.Equal(plan.DisplayName) ||
+		!state.DocumentEnrichmentConfiguration.Equal(plan.DocumentEnrichmentConfiguration) ||
+		!state.RoleArn.Equal(plan.RoleArn) ||
+		!state.SyncSchedule.Equal(plan.SyncSchedule) ||
+		!state.VpcConfiguration.Equal(plan.VpcConfiguration) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateDataSourceInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDataSource(ctx, input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameDataSource, plan.DataSourceId.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		applicationID, indexID, dataSourceID := plan.ApplicationId.ValueString(), plan.IndexId.ValueString(), plan.DataSourceId.ValueString()
+		if _, err := waitDataSourceActive(ctx, conn, applicationID, indexID, dataSourceID, r.UpdateTimeout(ctx, plan.Timeouts)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForUpdate, ResNameDataSource, dataSourceID, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceDataSource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceDataSourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	applicationID, indexID, dataSourceID := data.ApplicationId.ValueString(), data.IndexId.ValueString(), data.DataSourceId.ValueString()
+	input := &qbusiness.DeleteDataSourceInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	if _, err := conn.DeleteDataSource(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameDataSource, dataSourceID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitDataSourceDeleted(ctx, conn, applicationID, indexID, dataSourceID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNameDataSource, dataSourceID, err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceDataSource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(req.ID, dataSourceResourceIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: application_id,index_id,data_source_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrApplicationID), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("index_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_source_id"), parts[2])...)
+}
+
+func findDataSourceByThreePartKey(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) (*qbusiness.GetDataSourceOutput, error) {
+	input := &qbusiness.GetDataSourceInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.GetDataSource(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDataSource(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDataSourceByThreePartKey(ctx, conn, applicationID, indexID, dataSourceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDataSourceActive(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataSourceStatusPendingCreation, awstypes.DataSourceStatusCreating, awstypes.DataSourceStatusUpdating),
+		Target:     enum.Slice(awstypes.DataSourceStatusActive),
+		Refresh:    statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		tfresource.SetLastError(err, errorDetailError(output.Error))
+
+		return output, err
+	}
+	return nil, err
+}
+
+func waitDataSourceDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataSourceStatusActive, awstypes.DataSourceStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		tfresource.SetLastError(err, errorDetailError(output.Error))
+
+		return output, err
+	}
+	return nil, err
+}
+
+type resourceDataSourceData struct {
+	ApplicationId                   types.String                                                         `tfsdk:"application_id"`
+	Configuration                   fwtypes.SmithyJSON[document.Interface]                               `tfsdk:"configuration"`
+	DataSourceArn                   types.String                                                         `tfsdk:"arn"`
+	DataSourceId                    types.String                                                         `tfsdk:"data_source_id"`
+	Description                     types.String                                                         `tfsdk:"description"`
+	DisplayName                     types.String                                                         `tfsdk:"display_name"`
+	DocumentEnrichmentConfiguration fwtypes.ListNestedObjectValueOf[documentEnrichmentConfigurationData] `tfsdk:"document_enrichment_configuration"`
+	IndexId                         types.String                                                         `tfsdk:"index_id"`
+	RoleArn                         fwtypes.ARN                                                          `tfsdk:"iam_service_role_arn"`
+	SyncSchedule                    types.String                                                         `tfsdk:"sync_schedule"`
+	Tags                            tftags.Map                                                           `tfsdk:"tags"`
+	TagsAll                         tftags.Map                                                           `tfsdk:"tags_all"`
+	Timeouts                        timeouts.Value                                                       `tfsdk:"timeouts"`
+	Type                            types.String                                                         `tfsdk:"type"`
+	VpcConfiguration                fwtypes.ListNestedObjectValueOf[dataSourceVPCConfigurationData]      `tfsdk:"vpc_configuration"`
+}
+
+type dataSourceVPCConfigurationData struct {
+	SecurityGroupIds fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	SubnetIds        fwtypes.SetOfString `tfsdk:"subnet_ids"`
+}
+
+type documentEnrichmentConfigurationData struct {
+	InlineConfigurations            fwtypes.ListNestedObjectValueOf[inlineDocumentEnrichmentConfigurationData] `tfsdk:"inline_configuration"`
+	PostExtractionHookConfiguration fwtypes.ListNestedObjectValueOf[hookConfigurationData]                     `tfsdk:"post_extraction_hook_configuration"`
+	PreExtractionHookConfiguration  fwtypes.ListNestedObjectValueOf[hookConfigurationData]                     `tfsdk:"pre_extraction_hook_configuration"`
+}
+
+type inlineDocumentEnrichmentConfigurationData struct {
+	Condition               fwtypes.ListNestedObjectValueOf[documentAttributeConditionData] `tfsdk:"condition"`
+	DocumentContentOperator fwtypes.StringEnum[awstypes.DocumentContentOperator]            `tfsdk:"document_content_operator"`
+	Target                  fwtypes.ListNestedObjectValueOf[documentAttributeTargetData]    `tfsdk:"target"`
+}
+
+type documentAttributeConditionData struct {
+	Key      types.String                                                     `tfsdk:"key"`
+	Operator fwtypes.StringEnum[awstypes.DocumentEnrichmentConditionOperator] `tfsdk:"operator"`
+	Value    fwtypes.ListNestedObjectValueOf[documentAttributeValueData]      `tfsdk:"value"`
+}
+
+type documentAttributeTargetData struct {
+	AttributeValueOperator fwtypes.StringEnum[awstypes.AttributeValueOperator]         `tfsdk:"attribute_value_operator"`
+	Key                    types.String                                                `tfsdk:"key"`
+	Value                  fwtypes.ListNestedObjectValueOf[documentAttributeValueData] `tfsdk:"value"`
+}
+
+type hookConfigurationData struct {
+	InvocationCondition fwtypes.ListNestedObjectValueOf[documentAttributeConditionData] `tfsdk:"invocation_condition"`
+	LambdaArn           fwtypes.ARN                                                     `tfsdk:"lambda_arn"`
+	RoleArn             fwtypes.ARN                                                     `tfsdk:"role_arn"`
+	S3BucketName        types.String                                                    `tfsdk:"s3_bucket_name"`
+}
+
+type documentAttributeValueData struct {
+	DateValue       timetypes.RFC3339    `tfsdk:"date_value"`
+	LongValue       types.Int64          `tfsdk:"long_value"`
+	StringListValue fwtypes.ListOfString `tfsdk:"string_list_value"`
+	StringValue     types.String         `tfsdk:"string_value"`
+}
+
+func (m documentAttributeValueData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.DateValue.IsNull():
+		v, d := m.DateValue.ValueRFC3339Time()
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.DocumentAttributeValueMemberDateValue{Value: v}, diags
+
+	case !m.LongValue.IsNull():
+		return &awstypes.DocumentAttributeValueMemberLongValue{Value: m.LongValue.ValueInt64()}, diags
+
+	case !m.StringListValue.IsNull():
+		return &awstypes.DocumentAttributeValueMemberStringListValue{Value: fwflex.ExpandFrameworkStringValueList(ctx, m.StringListValue)}, diags
+
+	case !m.StringValue.IsNull():
+		return &awstypes.DocumentAttributeValueMemberStringValue{Value: m.StringValue.ValueString()}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *documentAttributeValueData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.DocumentAttributeValueMemberDateValue:
+		m.DateValue = timetypes.NewRFC3339TimeValue(t.Value)
+
+	case awstypes.DocumentAttributeValueMemberLongValue:
+		m.LongValue = types.Int64Value(t.Value)
+
+	case awstypes.DocumentAttributeValueMemberStringListValue:
+		m.StringListValue = fwflex.FlattenFrameworkStringValueListOfString(ctx, t.Value)
+
+	case awstypes.DocumentAttributeValueMemberStringValue:
+		m.StringValue = types.StringValue(t.Value)
+	}
+
+	return diags
+}
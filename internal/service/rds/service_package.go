@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func (p *servicePackage) TagPropagations(context.Context) []*types.ServicePackageTagPropagation {
+	return []*types.ServicePackageTagPropagation{
+		{
+			TypeName:                "aws_db_instance",
+			ChildResourceName:       "DB Snapshot",
+			ChildServicePackageName: names.RDS,
+			ListChildren:            listDBInstanceAutomatedSnapshotARNs,
+		},
+	}
+}
+
+// listDBInstanceAutomatedSnapshotARNs returns the ARNs of a DB instance's automated snapshots.
+// Manual snapshots are managed by `aws_db_snapshot` and are not considered child resources.
+func listDBInstanceAutomatedSnapshotARNs(ctx context.Context, meta any, id string) ([]string, error) {
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	input := rds.DescribeDBSnapshotsInput{
+		Filters: []awstypes.Filter{
+			{
+				Name:   aws.String("dbi-resource-id"),
+				Values: []string{id},
+			},
+		},
+		SnapshotType: aws.String("automated"),
+	}
+	output, err := findDBSnapshots(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.DBSnapshot]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.DBSnapshot) string {
+		return aws.ToString(v.DBSnapshotArn)
+	}), nil
+}
@@ -180,6 +180,16 @@ func resourceBucketObject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, objectUploadMaxConcurrency),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -365,7 +375,6 @@ func resourceBucketObjectImport(ctx context.Context, d *schema.ResourceData, met
 func resourceBucketObjectUpload(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
-	uploader := manager.NewUploader(conn)
 
 	var body io.ReadSeeker
 
@@ -484,7 +493,7 @@ func resourceBucketObjectUpload(ctx context.Context, d *schema.ResourceData, met
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	if _, err := uploader.Upload(ctx, input); err != nil {
+	if _, err := uploadObject(ctx, conn, input, int64(d.Get("upload_part_size").(int)), d.Get("upload_concurrency").(int)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
 	}
 
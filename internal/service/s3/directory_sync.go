@@ -0,0 +1,790 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncResourceIDPartCount = 2
+	directorySyncDefaultPartSize     = 16 * 1024 * 1024
+	directorySyncDefaultContentType  = "application/octet-stream"
+)
+
+// directorySyncContentTypes maps file name extensions to MIME types.
+// A fixed table is used instead of the host's MIME database so that plans don't depend on where Terraform runs.
+var directorySyncContentTypes = map[string]string{
+	".avif":  "image/avif",
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".gif":   "image/gif",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/vnd.microsoft.icon",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "text/javascript; charset=utf-8",
+	".json":  "application/json",
+	".map":   "application/json",
+	".md":    "text/markdown; charset=utf-8",
+	".mjs":   "text/javascript; charset=utf-8",
+	".mp4":   "video/mp4",
+	".otf":   "font/otf",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".svg":   "image/svg+xml",
+	".ttf":   "font/ttf",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".xml":   "text/xml; charset=utf-8",
+	".zip":   "application/zip",
+}
+
+// @FrameworkResource("aws_s3_directory_sync", name="Directory Sync")
+func newDirectorySyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directorySyncResource{}
+
+	return r, nil
+}
+
+type directorySyncResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *directorySyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"added_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"changed_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"content_types": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"delete_orphans": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrID: framework.IDAttribute(),
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest_digest": schema.StringAttribute{
+				Computed: true,
+			},
+			"part_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(directorySyncDefaultPartSize),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(manager.MinUploadPartSize),
+				},
+			},
+			"removed_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrSource: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *directorySyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	id, err := flex.FlattenResourceId([]string{data.Bucket.ValueString(), data.KeyPrefix.ValueString()}, directorySyncResourceIDPartCount, true)
+	if err != nil {
+		response.Diagnostics.AddError("creating S3 Directory Sync", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.sync(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, prefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	_, err := findBucket(ctx, conn, bucket)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	filter, err := data.managedKeyFilter()
+
+	if err != nil {
+		// The source directory may not be available where Terraform is run, e.g. in a CI pipeline's plan job.
+		tflog.Warn(ctx, "Unable to determine managed S3 objects, skipping refresh", map[string]any{
+			names.AttrSource: data.Source.ValueString(),
+			"error":          err.Error(),
+		})
+
+		return
+	}
+
+	remote, err := findDirectorySyncRemoteManifest(ctx, conn, bucket, prefix, filter)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The manifest digest reflects the remote objects, so that drift is detected.
+	data.ManifestDigest = types.StringValue(remote.digest())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	response.Diagnostics.Append(r.sync(ctx, conn, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directorySyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	filter, err := data.managedKeyFilter()
+
+	if err != nil {
+		response.Diagnostics.AddWarning(
+			fmt.Sprintf("deleting S3 Directory Sync (%s)", data.ID.ValueString()),
+			fmt.Sprintf("Unable to determine managed S3 objects, objects were not deleted: %s", err),
+		)
+
+		return
+	}
+
+	var keys []string
+	err = forEachDirectorySyncObject(ctx, conn, data.Bucket.ValueString(), data.KeyPrefix.ValueString(), func(key string) error {
+		if filter(key) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err == nil {
+		err = deleteDirectorySyncObjects(ctx, conn, data.Bucket.ValueString(), keys)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// ModifyPlan computes the local manifest and shows the keys that will be added, changed or removed.
+func (r *directorySyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.isKnown() {
+		plan.AddedKeys = types.SetUnknown(types.StringType)
+		plan.ChangedKeys = types.SetUnknown(types.StringType)
+		plan.ManifestDigest = types.StringUnknown()
+		plan.RemovedKeys = types.SetUnknown(types.StringType)
+
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+
+		return
+	}
+
+	local, err := plan.localManifest()
+
+	if err != nil {
+		response.Diagnostics.AddError("planning S3 Directory Sync", err.Error())
+
+		return
+	}
+
+	digest := local.digest()
+
+	if !request.State.Raw.IsNull() {
+		var state directorySyncResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// The refreshed manifest digest matches the local manifest, so the objects are in sync.
+		if plan.Bucket.Equal(state.Bucket) && plan.KeyPrefix.Equal(state.KeyPrefix) && plan.DeleteOrphans.Equal(state.DeleteOrphans) && state.ManifestDigest.ValueString() == digest {
+			plan.AddedKeys = state.AddedKeys
+			plan.ChangedKeys = state.ChangedKeys
+			plan.ManifestDigest = state.ManifestDigest
+			plan.RemovedKeys = state.RemovedKeys
+
+			response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+
+			return
+		}
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	remote, err := findDirectorySyncRemoteManifest(ctx, conn, plan.Bucket.ValueString(), plan.KeyPrefix.ValueString(), plan.remoteKeyFilter(local))
+
+	switch {
+	case tfresource.NotFound(err):
+		// The bucket is created in the same apply.
+		remote = directorySyncManifest{}
+	case err != nil:
+		response.Diagnostics.AddError(fmt.Sprintf("planning S3 Directory Sync (%s)", plan.Bucket.ValueString()), err.Error())
+
+		return
+	}
+
+	added, changed, removed := local.diff(remote, plan.DeleteOrphans.ValueBool())
+
+	plan.AddedKeys = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, added)
+	plan.ChangedKeys = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, changed)
+	plan.ManifestDigest = types.StringValue(digest)
+	plan.RemovedKeys = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, removed)
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+// sync uploads added and changed files and deletes removed objects.
+func (r *directorySyncResource) sync(ctx context.Context, conn *s3.Client, data *directorySyncResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, prefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	local, err := data.localManifest()
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("syncing S3 Directory Sync (%s)", bucket), err.Error())
+
+		return diags
+	}
+
+	digest := local.digest()
+	if !data.ManifestDigest.IsUnknown() && data.ManifestDigest.ValueString() != digest {
+		diags.AddError(fmt.Sprintf("syncing S3 Directory Sync (%s)", bucket), fmt.Sprintf("source directory (%s) changed after plan", data.Source.ValueString()))
+
+		return diags
+	}
+
+	var added, changed, removed []string
+	if data.AddedKeys.IsUnknown() || data.ChangedKeys.IsUnknown() || data.RemovedKeys.IsUnknown() {
+		remote, err := findDirectorySyncRemoteManifest(ctx, conn, bucket, prefix, data.remoteKeyFilter(local))
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("syncing S3 Directory Sync (%s)", bucket), err.Error())
+
+			return diags
+		}
+
+		added, changed, removed = local.diff(remote, data.DeleteOrphans.ValueBool())
+	} else {
+		added = fwflex.ExpandFrameworkStringValueSet(ctx, data.AddedKeys)
+		changed = fwflex.ExpandFrameworkStringValueSet(ctx, data.ChangedKeys)
+		removed = fwflex.ExpandFrameworkStringValueSet(ctx, data.RemovedKeys)
+	}
+
+	partSize := data.PartSize.ValueInt64()
+
+	for _, key := range slices.Sorted(slices.Values(append(added, changed...))) {
+		entry, ok := local[key]
+		if !ok {
+			continue
+		}
+
+		if err := uploadDirectorySyncObject(ctx, conn, partSize, bucket, key, entry); err != nil {
+			diags.AddError(fmt.Sprintf("uploading S3 Object (%s) to Bucket (%s)", key, bucket), err.Error())
+
+			return diags
+		}
+	}
+
+	if err := deleteDirectorySyncObjects(ctx, conn, bucket, removed); err != nil {
+		diags.AddError(fmt.Sprintf("syncing S3 Directory Sync (%s)", bucket), err.Error())
+
+		return diags
+	}
+
+	data.AddedKeys = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, added)
+	data.ChangedKeys = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, changed)
+	data.ManifestDigest = types.StringValue(digest)
+	data.RemovedKeys = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, removed)
+
+	return diags
+}
+
+func uploadDirectorySyncObject(ctx context.Context, conn *s3.Client, partSize int64, bucket, key string, entry directorySyncManifestEntry) error {
+	file, err := os.Open(entry.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Files larger than the part size are uploaded using multipart upload.
+	// Incomplete multipart uploads are aborted on failure.
+	input := &s3.PutObjectInput{
+		Body:              file,
+		Bucket:            aws.String(bucket),
+		ChecksumAlgorithm: awstypes.ChecksumAlgorithmSha256,
+		ContentType:       aws.String(entry.contentType),
+		Key:               aws.String(key),
+	}
+
+	_, err = uploadObject(ctx, conn, input, partSize, 0)
+
+	return err
+}
+
+func deleteDirectorySyncObjects(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	toDelete := make([]awstypes.ObjectIdentifier, 0, len(keys))
+	for _, key := range keys {
+		toDelete = append(toDelete, awstypes.ObjectIdentifier{
+			Key: aws.String(key),
+		})
+	}
+
+	// DeleteObjects deletes at most 1,000 objects per request.
+	for chunk := range slices.Chunk(toDelete, 1000) {
+		if _, err := deletePage(ctx, conn, bucket, false, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func forEachDirectorySyncObject(ctx context.Context, conn *s3.Client, bucket, prefix string, fn func(key string) error) error {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.Contents {
+			key := aws.ToString(v.Key)
+
+			// Skip "folder" placeholder objects.
+			if strings.HasSuffix(key, "/") {
+				continue
+			}
+
+			if err := fn(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// findDirectorySyncRemoteManifest returns the manifest of the objects under the prefix whose keys match the filter.
+func findDirectorySyncRemoteManifest(ctx context.Context, conn *s3.Client, bucket, prefix string, filter func(string) bool) (directorySyncManifest, error) {
+	manifest := directorySyncManifest{}
+
+	err := forEachDirectorySyncObject(ctx, conn, bucket, prefix, func(key string) error {
+		if !filter(key) {
+			return nil
+		}
+
+		output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", string(awstypes.ChecksumAlgorithmSha256))
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading S3 Object (%s): %w", key, err)
+		}
+
+		manifest[key] = directorySyncManifestEntry{
+			checksumSHA256: aws.ToString(output.ChecksumSHA256),
+			contentType:    aws.ToString(output.ContentType),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+type directorySyncManifestEntry struct {
+	// Local file path. Empty for remote objects.
+	path string
+	// Base64-encoded SHA256 checksum, as returned by S3.
+	// For objects uploaded using multipart upload, this is the checksum of the parts' checksums followed by the number of parts.
+	checksumSHA256 string
+	contentType    string
+}
+
+// directorySyncManifest maps object keys to manifest entries.
+type directorySyncManifest map[string]directorySyncManifestEntry
+
+// digest returns a hex-encoded SHA256 hash of the manifest.
+func (m directorySyncManifest) digest() string {
+	h := sha256.New()
+
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		v := m[key]
+		fmt.Fprintf(h, "%s\t%s\t%s\n", key, v.checksumSHA256, v.contentType)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// diff returns the keys that are added, changed or (if deleteOrphans is set) removed when syncing to the remote manifest.
+func (m directorySyncManifest) diff(remote directorySyncManifest, deleteOrphans bool) ([]string, []string, []string) {
+	var added, changed, removed []string
+
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		if v, ok := remote[key]; !ok {
+			added = append(added, key)
+		} else if v.checksumSHA256 != m[key].checksumSHA256 || v.contentType != m[key].contentType {
+			changed = append(changed, key)
+		}
+	}
+
+	if deleteOrphans {
+		for _, key := range slices.Sorted(maps.Keys(remote)) {
+			if _, ok := m[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+	}
+
+	return added, changed, removed
+}
+
+// listDirectorySyncFiles returns the paths of the regular files in the source directory, keyed by object key.
+func listDirectorySyncFiles(source, prefix string) (map[string]string, error) {
+	dir, err := homedir.Expand(source)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string)
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to files. Symbolic links to directories are ignored.
+		fi, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[prefix+filepath.ToSlash(rel)] = path
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", source, err)
+	}
+
+	return files, nil
+}
+
+// directorySyncContentType returns the MIME type for the specified file name.
+func directorySyncContentType(name string, overrides map[string]string) string {
+	ext := strings.ToLower(filepath.Ext(name))
+
+	if v, ok := overrides[ext]; ok {
+		return v
+	}
+	if v, ok := directorySyncContentTypes[ext]; ok {
+		return v
+	}
+
+	return directorySyncDefaultContentType
+}
+
+// directorySyncFileChecksumSHA256 returns the checksum that S3 reports for the specified file once uploaded with the specified part size.
+func directorySyncFileChecksumSHA256(path string, partSize int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	fi, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	// Replicate the transfer manager's part size adjustment.
+	size := fi.Size()
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = (size / int64(manager.MaxUploadParts)) + 1
+	}
+
+	if size <= partSize {
+		h := sha256.New()
+		if _, err := io.Copy(h, file); err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}
+
+	composite := sha256.New()
+	var nParts int
+	for {
+		h := sha256.New()
+		n, err := io.CopyN(h, file, partSize)
+
+		if n > 0 {
+			composite.Write(h.Sum(nil))
+			nParts++
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(composite.Sum(nil)), nParts), nil
+}
+
+type directorySyncResourceModel struct {
+	AddedKeys      types.Set    `tfsdk:"added_keys"`
+	Bucket         types.String `tfsdk:"bucket"`
+	ChangedKeys    types.Set    `tfsdk:"changed_keys"`
+	ContentTypes   types.Map    `tfsdk:"content_types"`
+	DeleteOrphans  types.Bool   `tfsdk:"delete_orphans"`
+	ID             types.String `tfsdk:"id"`
+	KeyPrefix      types.String `tfsdk:"key_prefix"`
+	ManifestDigest types.String `tfsdk:"manifest_digest"`
+	PartSize       types.Int64  `tfsdk:"part_size"`
+	RemovedKeys    types.Set    `tfsdk:"removed_keys"`
+	Source         types.String `tfsdk:"source"`
+}
+
+// isKnown returns whether all the arguments that determine the manifest are known.
+func (m *directorySyncResourceModel) isKnown() bool {
+	return !m.Bucket.IsUnknown() &&
+		!m.ContentTypes.IsUnknown() &&
+		!m.DeleteOrphans.IsUnknown() &&
+		!m.KeyPrefix.IsUnknown() &&
+		!m.PartSize.IsUnknown() &&
+		!m.Source.IsUnknown()
+}
+
+// localManifest returns the manifest of the files in the source directory.
+func (m *directorySyncResourceModel) localManifest() (directorySyncManifest, error) {
+	files, err := listDirectorySyncFiles(m.Source.ValueString(), m.KeyPrefix.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string]string)
+	for k, v := range m.ContentTypes.Elements() {
+		if v, ok := v.(types.String); ok {
+			overrides[strings.ToLower(k)] = v.ValueString()
+		}
+	}
+
+	manifest := directorySyncManifest{}
+	for key, path := range files {
+		checksum, err := directorySyncFileChecksumSHA256(path, m.PartSize.ValueInt64())
+		if err != nil {
+			return nil, fmt.Errorf("hashing %s: %w", path, err)
+		}
+
+		manifest[key] = directorySyncManifestEntry{
+			path:           path,
+			checksumSHA256: checksum,
+			contentType:    directorySyncContentType(path, overrides),
+		}
+	}
+
+	return manifest, nil
+}
+
+// managedKeyFilter returns a filter matching the keys of the objects managed by the resource.
+// If orphans are deleted, all objects under the prefix are managed, otherwise only objects that correspond to files in the source directory.
+func (m *directorySyncResourceModel) managedKeyFilter() (func(string) bool, error) {
+	if m.DeleteOrphans.ValueBool() {
+		return func(string) bool { return true }, nil
+	}
+
+	files, err := listDirectorySyncFiles(m.Source.ValueString(), m.KeyPrefix.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return func(key string) bool {
+		_, ok := files[key]
+		return ok
+	}, nil
+}
+
+// remoteKeyFilter returns a filter matching the keys of the objects managed by the resource, based on the local manifest.
+func (m *directorySyncResourceModel) remoteKeyFilter(local directorySyncManifest) func(string) bool {
+	if m.DeleteOrphans.ValueBool() {
+		return func(string) bool { return true }
+	}
+
+	return func(key string) bool {
+		_, ok := local[key]
+		return ok
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDirectorySyncFileChecksumSHA256(t *testing.T) {
+	t.Parallel()
+
+	const partSize = 5 * 1024 * 1024
+	dir := t.TempDir()
+
+	testCases := map[string]struct {
+		size     int
+		expected func([]byte) string
+	}{
+		"empty": {
+			size: 0,
+			expected: func(b []byte) string {
+				return base64SHA256(b)
+			},
+		},
+		"single part": {
+			size: partSize,
+			expected: func(b []byte) string {
+				return base64SHA256(b)
+			},
+		},
+		"multipart": {
+			size: 2*partSize + 1,
+			expected: func(b []byte) string {
+				var parts []byte
+				for i := 0; i < len(b); i += partSize {
+					h := sha256.Sum256(b[i:min(i+partSize, len(b))])
+					parts = append(parts, h[:]...)
+				}
+				return base64SHA256(parts) + "-3"
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			content := []byte(strings.Repeat("x", testCase.size))
+			path := filepath.Join(dir, strings.ReplaceAll(name, " ", "_"))
+			if err := os.WriteFile(path, content, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tfs3.DirectorySyncFileChecksumSHA256(path, partSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if want := testCase.expected(content); got != want {
+				t.Errorf("checksum = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func base64SHA256(b []byte) string {
+	h := sha256.Sum256(b)
+	return base64.StdEncoding.EncodeToString(h[:])
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx, rName, "site/index.html", "site/css/style.css"),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccWriteDirectorySyncFile(t, source, "index.html", "<html></html>")
+					testAccWriteDirectorySyncFile(t, source, "css/style.css", "body {}")
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("added_keys"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("site/css/style.css"),
+							knownvalue.StringExact("site/index.html"),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObject(ctx, rName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObject(ctx, rName, "site/css/style.css", "text/css; charset=utf-8"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("changed_keys"), knownvalue.SetSizeExact(0)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("delete_orphans"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("key_prefix"), knownvalue.StringExact("site/")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("manifest_digest"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("removed_keys"), knownvalue.SetSizeExact(0)),
+				},
+			},
+			{
+				PreConfig: func() {
+					testAccWriteDirectorySyncFile(t, source, "index.html", "<html><body></body></html>")
+					if err := os.Remove(filepath.Join(source, "css", "style.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("added_keys"), knownvalue.SetSizeExact(0)),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("changed_keys"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("site/index.html"),
+						})),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("removed_keys"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("site/css/style.css"),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObject(ctx, rName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, rName, "site/css/style.css"),
+				),
+			},
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccWriteDirectorySyncFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckDirectorySyncObject(ctx context.Context, bucket, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "SHA256")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) content type = %s, want %s", key, got, contentType)
+		}
+
+		if aws.ToString(output.ChecksumSHA256) == "" {
+			return fmt.Errorf("S3 Object (%s) has no SHA256 checksum", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) still exists", key)
+	}
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context, bucket string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			for _, key := range keys {
+				if err := testAccCheckDirectorySyncObjectNotExists(ctx, bucket, key)(s); err != nil && !tfresource.NotFound(err) {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string, deleteOrphans bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site/"
+  source         = %[2]q
+  delete_orphans = %[3]t
+}
+`, rName, source, deleteOrphans)
+}
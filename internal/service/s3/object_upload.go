@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	objectUploadAbortTimeout   = 2 * time.Minute
+	objectUploadMaxConcurrency = 64
+)
+
+// uploadObject uploads an object using the S3 transfer manager.
+// Bodies larger than the part size are uploaded using multipart upload, streaming each part from the body
+// (files are read in place via io.ReaderAt) so that the object is neither held in memory nor read twice.
+// Each part's checksum is calculated as the part is sent and S3 combines them into the object's composite checksum.
+// An incomplete multipart upload is aborted on failure, including when ctx is canceled.
+func uploadObject(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, partSize int64, concurrency int, optFns ...func(*s3.Options)) (*manager.UploadOutput, error) {
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+		if partSize > 0 {
+			u.PartSize = partSize
+		}
+		if concurrency > 0 {
+			u.Concurrency = concurrency
+		}
+		// The transfer manager aborts using the (possibly canceled) upload context, so abort here instead.
+		u.LeavePartsOnError = true
+	})
+
+	output, err := uploader.Upload(ctx, input)
+
+	if v, ok := errs.As[manager.MultiUploadFailure](err); ok && v.UploadID() != "" {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), objectUploadAbortTimeout)
+		defer cancel()
+
+		if abortErr := abortMultipartUpload(ctx, conn, aws.ToString(input.Bucket), aws.ToString(input.Key), v.UploadID(), optFns...); abortErr != nil {
+			err = errors.Join(err, abortErr)
+		}
+	}
+
+	return output, err
+}
+
+func abortMultipartUpload(ctx context.Context, conn *s3.Client, bucket, key, uploadID string, optFns ...func(*s3.Options)) error {
+	input := &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	}
+
+	_, err := conn.AbortMultipartUpload(ctx, input, optFns...)
+
+	if errs.IsA[*types.NoSuchUpload](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("aborting S3 Object (%s) multipart upload (%s): %w", key, uploadID, err)
+	}
+
+	return nil
+}
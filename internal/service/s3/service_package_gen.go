@@ -36,6 +36,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_s3_directory_bucket",
 			Name:     "Directory Bucket",
 		},
+		{
+			Factory:  newDirectorySyncResource,
+			TypeName: "aws_s3_directory_sync",
+			Name:     "Directory Sync",
+		},
 	}
 }
 
@@ -17,6 +17,7 @@ var (
 	ResourceBucketPolicy                       = resourceBucketPolicy
 	ResourceMultiRegionAccessPoint             = resourceMultiRegionAccessPoint
 	ResourceMultiRegionAccessPointPolicy       = resourceMultiRegionAccessPointPolicy
+	ResourceMultiRegionAccessPointRoutes       = resourceMultiRegionAccessPointRoutes
 	ResourceObjectLambdaAccessPoint            = resourceObjectLambdaAccessPoint
 	ResourceObjectLambdaAccessPointPolicy      = resourceObjectLambdaAccessPointPolicy
 	ResourceStorageLensConfiguration           = resourceStorageLensConfiguration
@@ -32,6 +33,7 @@ var (
 	FindBucketPolicyByTwoPartKey                           = findBucketPolicyByTwoPartKey
 	FindMultiRegionAccessPointByTwoPartKey                 = findMultiRegionAccessPointByTwoPartKey
 	FindMultiRegionAccessPointPolicyDocumentByTwoPartKey   = findMultiRegionAccessPointPolicyDocumentByTwoPartKey
+	FindMultiRegionAccessPointRoutesByTwoPartKey           = findMultiRegionAccessPointRoutesByTwoPartKey
 	FindObjectLambdaAccessPointAliasByTwoPartKey           = findObjectLambdaAccessPointAliasByTwoPartKey
 	FindObjectLambdaAccessPointConfigurationByTwoPartKey   = findObjectLambdaAccessPointConfigurationByTwoPartKey
 	FindObjectLambdaAccessPointPolicyAndStatusByTwoPartKey = findObjectLambdaAccessPointPolicyAndStatusByTwoPartKey
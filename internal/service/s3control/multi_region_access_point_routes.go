@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3control_multi_region_access_point_routes", name="Multi-Region Access Point Routes")
+func resourceMultiRegionAccessPointRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMultiRegionAccessPointRoutesPut,
+		ReadWithoutTimeout:   resourceMultiRegionAccessPointRoutesRead,
+		UpdateWithoutTimeout: resourceMultiRegionAccessPointRoutesPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"mrap": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"traffic_dial_percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	multiRegionAccessPointRoutesResourceIDPartCount = 2
+)
+
+func resourceMultiRegionAccessPointRoutesPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	accountID := meta.(*conns.AWSClient).AccountID(ctx)
+	if v, ok := d.GetOk(names.AttrAccountID); ok {
+		accountID = v.(string)
+	}
+	mrap := d.Get("mrap").(string)
+	id, err := flex.FlattenResourceId([]string{accountID, mrap}, multiRegionAccessPointRoutesResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	routes := expandMultiRegionAccessPointRoutes(d.Get("route").(*schema.Set).List())
+	input := &s3control.SubmitMultiRegionAccessPointRoutesInput{
+		AccountId:    aws.String(accountID),
+		Mrap:         aws.String(mrap),
+		RouteUpdates: routes,
+	}
+
+	_, err = conn.SubmitMultiRegionAccessPointRoutes(ctx, input, func(o *s3control.Options) {
+		// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
+		o.Region = endpoints.UsWest2RegionID
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "submitting S3 Multi-Region Access Point Routes (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	if err := waitMultiRegionAccessPointRoutesPropagated(ctx, conn, accountID, mrap, routes, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for S3 Multi-Region Access Point Routes (%s) propagation: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMultiRegionAccessPointRoutesRead(ctx, d, meta)...)
+}
+
+func resourceMultiRegionAccessPointRoutesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3ControlClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), multiRegionAccessPointRoutesResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	accountID, mrap := parts[0], parts[1]
+
+	routes, err := findMultiRegionAccessPointRoutesByTwoPartKey(ctx, conn, accountID, mrap)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Multi-Region Access Point Routes (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Multi-Region Access Point Routes (%s): %s", d.Id(), err)
+	}
+
+	// Only the routes of configured buckets are managed. On import there are none yet, so all routes are read.
+	if v := d.Get("route").(*schema.Set).List(); len(v) > 0 {
+		buckets := tfslices.ApplyToAll(expandMultiRegionAccessPointRoutes(v), func(v types.MultiRegionAccessPointRoute) string {
+			return aws.ToString(v.Bucket)
+		})
+		routes = tfslices.Filter(routes, func(v types.MultiRegionAccessPointRoute) bool {
+			return slices.Contains(buckets, aws.ToString(v.Bucket))
+		})
+	}
+
+	d.Set(names.AttrAccountID, accountID)
+	d.Set("mrap", mrap)
+	if err := d.Set("route", flattenMultiRegionAccessPointRoutes(routes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+	}
+
+	return diags
+}
+
+func findMultiRegionAccessPointRoutesByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, mrap string) ([]types.MultiRegionAccessPointRoute, error) {
+	input := &s3control.GetMultiRegionAccessPointRoutesInput{
+		AccountId: aws.String(accountID),
+		Mrap:      aws.String(mrap),
+	}
+
+	output, err := conn.GetMultiRegionAccessPointRoutes(ctx, input, func(o *s3control.Options) {
+		// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
+		o.Region = endpoints.UsWest2RegionID
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchMultiRegionAccessPoint) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Routes) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Routes, nil
+}
+
+// waitMultiRegionAccessPointRoutesPropagated waits until the routing configuration
+// reported by the service matches the submitted traffic dial percentages.
+func waitMultiRegionAccessPointRoutesPropagated(ctx context.Context, conn *s3control.Client, accountID, mrap string, want []types.MultiRegionAccessPointRoute, timeout time.Duration) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		routes, err := findMultiRegionAccessPointRoutesByTwoPartKey(ctx, conn, accountID, mrap)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		dials := make(map[string]int32, len(routes))
+		for _, route := range routes {
+			dials[aws.ToString(route.Bucket)] = aws.ToInt32(route.TrafficDialPercentage)
+		}
+
+		for _, route := range want {
+			if v, ok := dials[aws.ToString(route.Bucket)]; !ok || v != aws.ToInt32(route.TrafficDialPercentage) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		Delay:                     5 * time.Second,
+		MinTimeout:                5 * time.Second,
+	})
+}
+
+func expandMultiRegionAccessPointRoutes(tfList []any) []types.MultiRegionAccessPointRoute {
+	apiObjects := make([]types.MultiRegionAccessPointRoute, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.MultiRegionAccessPointRoute{
+			Bucket:                aws.String(tfMap[names.AttrBucket].(string)),
+			Region:                aws.String(tfMap[names.AttrRegion].(string)),
+			TrafficDialPercentage: aws.Int32(int32(tfMap["traffic_dial_percentage"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenMultiRegionAccessPointRoutes(apiObjects []types.MultiRegionAccessPointRoute) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrBucket:          aws.ToString(apiObject.Bucket),
+			names.AttrRegion:          aws.ToString(apiObject.Region),
+			"traffic_dial_percentage": aws.ToInt32(apiObject.TrafficDialPercentage),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,588 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sagemaker_cluster", name="Cluster")
+// @Tags(identifierAttribute="arn")
+func resourceCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterCreate,
+		ReadWithoutTimeout:   resourceClusterRead,
+		UpdateWithoutTimeout: resourceClusterUpdate,
+		DeleteWithoutTimeout: resourceClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"instance_group": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"execution_role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrInstanceCount: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"instance_group_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+						"instance_storage_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ebs_volume_config": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 16384),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrInstanceType: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ClusterInstanceType](),
+						},
+						"lifecycle_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_create": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"source_s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"on_start_deep_health_checks": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: enum.Validate[awstypes.DeepHealthCheckType](),
+							},
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"threads_per_core": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 2),
+						},
+					},
+				},
+			},
+			"node_recovery": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ClusterNodeRecovery](),
+			},
+			"orchestrator": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"eks": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVPCConfig: {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSubnets: {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 16,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	name := d.Get(names.AttrClusterName).(string)
+	input := &sagemaker.CreateClusterInput{
+		ClusterName:    aws.String(name),
+		InstanceGroups: expandClusterInstanceGroupSpecifications(d.Get("instance_group").([]any)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("node_recovery"); ok {
+		input.NodeRecovery = awstypes.ClusterNodeRecovery(v.(string))
+	}
+
+	if v, ok := d.GetOk("orchestrator"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.Orchestrator = expandClusterOrchestrator(v.([]any))
+	}
+
+	if v, ok := d.GetOk(names.AttrVPCConfig); ok {
+		input.VpcConfig = expandVPCConfigRequest(v.([]any))
+	}
+
+	_, err := conn.CreateCluster(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker AI Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitClusterInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker AI Cluster (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceClusterRead(ctx, d, meta)...)
+}
+
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	output, err := findClusterByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker AI Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker AI Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ClusterArn)
+	d.Set(names.AttrClusterName, output.ClusterName)
+	if err := d.Set("instance_group", flattenClusterInstanceGroupDetails(output.InstanceGroups)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_group: %s", err)
+	}
+	d.Set("node_recovery", output.NodeRecovery)
+	if err := d.Set("orchestrator", flattenClusterOrchestrator(output.Orchestrator)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting orchestrator: %s", err)
+	}
+	if err := d.Set(names.AttrVPCConfig, flattenVPCConfigResponse(output.VpcConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
+	}
+
+	return diags
+}
+
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	if d.HasChanges("instance_group", "node_recovery") {
+		input := &sagemaker.UpdateClusterInput{
+			ClusterName:    aws.String(d.Id()),
+			InstanceGroups: expandClusterInstanceGroupSpecifications(d.Get("instance_group").([]any)),
+		}
+
+		if d.HasChange("instance_group") {
+			o, n := d.GetChange("instance_group")
+			input.InstanceGroupsToDelete = clusterInstanceGroupsToDelete(o.([]any), n.([]any))
+		}
+
+		if v, ok := d.GetOk("node_recovery"); ok {
+			input.NodeRecovery = awstypes.ClusterNodeRecovery(v.(string))
+		}
+
+		_, err := conn.UpdateCluster(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker AI Cluster (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitClusterInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker AI Cluster (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceClusterRead(ctx, d, meta)...)
+}
+
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	log.Printf("[INFO] Deleting SageMaker AI Cluster: %s", d.Id())
+	_, err := conn.DeleteCluster(ctx, &sagemaker.DeleteClusterInput{
+		ClusterName: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker AI Cluster (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker AI Cluster (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findClusterByName(ctx context.Context, conn *sagemaker.Client, name string) (*sagemaker.DescribeClusterOutput, error) {
+	input := &sagemaker.DescribeClusterInput{
+		ClusterName: aws.String(name),
+	}
+
+	output, err := conn.DescribeCluster(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusCluster(ctx context.Context, conn *sagemaker.Client, name string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findClusterByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ClusterStatus), nil
+	}
+}
+
+func waitClusterInService(ctx context.Context, conn *sagemaker.Client, name string, timeout time.Duration) (*sagemaker.DescribeClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusCreating, awstypes.ClusterStatusUpdating, awstypes.ClusterStatusSystemupdating),
+		Target:  enum.Slice(awstypes.ClusterStatusInservice),
+		Refresh: statusCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeClusterOutput); ok {
+		tfresource.SetLastError(err, clusterFailureError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *sagemaker.Client, name string, timeout time.Duration) (*sagemaker.DescribeClusterOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ClusterStatusDeleting),
+		Target:  []string{},
+		Refresh: statusCluster(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeClusterOutput); ok {
+		tfresource.SetLastError(err, clusterFailureError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// clusterFailureError combines the cluster-level failure message with the status of
+// every instance group that didn't reach InService, as a cluster failure is usually
+// caused by a single misconfigured group (e.g. a lifecycle script error or capacity).
+func clusterFailureError(output *sagemaker.DescribeClusterOutput) error {
+	var failures []error
+
+	if v := aws.ToString(output.FailureMessage); v != "" {
+		failures = append(failures, errors.New(v))
+	}
+
+	for _, v := range output.InstanceGroups {
+		switch status := v.Status; status {
+		case awstypes.InstanceGroupStatusFailed, awstypes.InstanceGroupStatusDegraded:
+			failures = append(failures, fmt.Errorf("instance group (%s): %s (%d/%d instances)", aws.ToString(v.InstanceGroupName), status, aws.ToInt32(v.CurrentCount), aws.ToInt32(v.TargetCount)))
+		}
+	}
+
+	return errors.Join(failures...)
+}
+
+// clusterInstanceGroupsToDelete returns the names of instance groups that are no
+// longer configured, as UpdateCluster requires removed groups to be listed explicitly.
+func clusterInstanceGroupsToDelete(o, n []any) []string {
+	configured := make(map[string]struct{})
+	for _, tfMapRaw := range n {
+		if tfMap, ok := tfMapRaw.(map[string]any); ok {
+			configured[tfMap["instance_group_name"].(string)] = struct{}{}
+		}
+	}
+
+	var groupNames []string
+	for _, tfMapRaw := range o {
+		if tfMap, ok := tfMapRaw.(map[string]any); ok {
+			name := tfMap["instance_group_name"].(string)
+			if _, ok := configured[name]; !ok {
+				groupNames = append(groupNames, name)
+			}
+		}
+	}
+
+	return groupNames
+}
+
+func expandClusterInstanceGroupSpecifications(tfList []any) []awstypes.ClusterInstanceGroupSpecification {
+	var apiObjects []awstypes.ClusterInstanceGroupSpecification
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ClusterInstanceGroupSpecification{
+			ExecutionRole:     aws.String(tfMap["execution_role"].(string)),
+			InstanceCount:     aws.Int32(int32(tfMap[names.AttrInstanceCount].(int))),
+			InstanceGroupName: aws.String(tfMap["instance_group_name"].(string)),
+			InstanceType:      awstypes.ClusterInstanceType(tfMap[names.AttrInstanceType].(string)),
+		}
+
+		if v, ok := tfMap["instance_storage_config"].([]any); ok && len(v) > 0 && v[0] != nil {
+			apiObject.InstanceStorageConfigs = expandClusterInstanceStorageConfigs(v[0].(map[string]any))
+		}
+
+		if v, ok := tfMap["lifecycle_config"].([]any); ok && len(v) > 0 && v[0] != nil {
+			lifeCycleConfig := v[0].(map[string]any)
+			apiObject.LifeCycleConfig = &awstypes.ClusterLifeCycleConfig{
+				OnCreate:    aws.String(lifeCycleConfig["on_create"].(string)),
+				SourceS3Uri: aws.String(lifeCycleConfig["source_s3_uri"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["on_start_deep_health_checks"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.OnStartDeepHealthChecks = flex.ExpandStringyValueSet[awstypes.DeepHealthCheckType](v)
+		}
+
+		if v, ok := tfMap["threads_per_core"].(int); ok && v != 0 {
+			apiObject.ThreadsPerCore = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandClusterInstanceStorageConfigs(tfMap map[string]any) []awstypes.ClusterInstanceStorageConfig {
+	var apiObjects []awstypes.ClusterInstanceStorageConfig
+
+	if v, ok := tfMap["ebs_volume_config"].([]any); ok && len(v) > 0 && v[0] != nil {
+		ebsVolumeConfig := v[0].(map[string]any)
+		apiObjects = append(apiObjects, &awstypes.ClusterInstanceStorageConfigMemberEbsVolumeConfig{
+			Value: awstypes.ClusterEbsVolumeConfig{
+				VolumeSizeInGB: aws.Int32(int32(ebsVolumeConfig["volume_size_in_gb"].(int))),
+			},
+		})
+	}
+
+	return apiObjects
+}
+
+func expandClusterOrchestrator(tfList []any) *awstypes.ClusterOrchestrator {
+	tfMap := tfList[0].(map[string]any)
+	apiObject := &awstypes.ClusterOrchestrator{}
+
+	if v, ok := tfMap["eks"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Eks = &awstypes.ClusterOrchestratorEksConfig{
+			ClusterArn: aws.String(v[0].(map[string]any)["cluster_arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenClusterInstanceGroupDetails(apiObjects []awstypes.ClusterInstanceGroupDetails) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"current_count":               aws.ToInt32(apiObject.CurrentCount),
+			"execution_role":              aws.ToString(apiObject.ExecutionRole),
+			names.AttrInstanceCount:       aws.ToInt32(apiObject.TargetCount),
+			"instance_group_name":         aws.ToString(apiObject.InstanceGroupName),
+			"instance_storage_config":     flattenClusterInstanceStorageConfigs(apiObject.InstanceStorageConfigs),
+			names.AttrInstanceType:        string(apiObject.InstanceType),
+			"on_start_deep_health_checks": flex.FlattenStringyValueSet(apiObject.OnStartDeepHealthChecks),
+			names.AttrStatus:              string(apiObject.Status),
+			"threads_per_core":            aws.ToInt32(apiObject.ThreadsPerCore),
+		}
+
+		if v := apiObject.LifeCycleConfig; v != nil {
+			tfMap["lifecycle_config"] = []any{map[string]any{
+				"on_create":     aws.ToString(v.OnCreate),
+				"source_s3_uri": aws.ToString(v.SourceS3Uri),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenClusterInstanceStorageConfigs(apiObjects []awstypes.ClusterInstanceStorageConfig) []any {
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *awstypes.ClusterInstanceStorageConfigMemberEbsVolumeConfig:
+			return []any{map[string]any{
+				"ebs_volume_config": []any{map[string]any{
+					"volume_size_in_gb": aws.ToInt32(v.Value.VolumeSizeInGB),
+				}},
+			}}
+		}
+	}
+
+	return nil
+}
+
+func flattenClusterOrchestrator(apiObject *awstypes.ClusterOrchestrator) []any {
+	if apiObject == nil || apiObject.Eks == nil {
+		return nil
+	}
+
+	return []any{map[string]any{
+		"eks": []any{map[string]any{
+			"cluster_arn": aws.ToString(apiObject.Eks.ClusterArn),
+		}},
+	}}
+}
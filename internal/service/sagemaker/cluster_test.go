@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSageMakerCluster_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrClusterName, rName),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "sagemaker", regexache.MustCompile(`cluster/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.0.instance_group_name", "controller"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.0.instance_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.0.current_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.0.instance_type", "ml.t3.medium"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.0.lifecycle_config.0.on_create", "on_create.sh"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.0.status", "InService"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_group.0.execution_role", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "orchestrator.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerCluster_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsagemaker.ResourceCluster(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerCluster_instanceGroups(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.0.instance_count", "1"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_basic(rName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.0.instance_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.0.current_count", "2"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_twoInstanceGroups(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.1.instance_group_name", "worker"),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.1.instance_storage_config.0.ebs_volume_config.0.volume_size_in_gb", "100"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_group.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerCluster_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1Updated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sagemaker_cluster" {
+				continue
+			}
+
+			_, err := tfsagemaker.FindClusterByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SageMaker AI Cluster (%s) still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckClusterExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerClient(ctx)
+
+		_, err := tfsagemaker.FindClusterByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccClusterConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["sagemaker.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume_role.json
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonSageMakerClusterInstanceRolePolicy"
+}
+
+# The managed instance role policy only grants access to buckets prefixed with "sagemaker-".
+resource "aws_s3_bucket" "test" {
+  bucket        = "sagemaker-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "lifecycle/on_create.sh"
+  content = "#!/bin/bash\necho 'lifecycle script complete'\n"
+}
+`, rName)
+}
+
+func testAccClusterConfig_basic(rName string, instanceCount int) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_cluster" "test" {
+  cluster_name = %[1]q
+
+  instance_group {
+    instance_group_name = "controller"
+    instance_type       = "ml.t3.medium"
+    instance_count      = %[2]d
+    execution_role      = aws_iam_role.test.arn
+
+    lifecycle_config {
+      source_s3_uri = "s3://${aws_s3_bucket.test.id}/lifecycle/"
+      on_create     = "on_create.sh"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_s3_object.test]
+}
+`, rName, instanceCount))
+}
+
+func testAccClusterConfig_twoInstanceGroups(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_cluster" "test" {
+  cluster_name = %[1]q
+
+  instance_group {
+    instance_group_name = "controller"
+    instance_type       = "ml.t3.medium"
+    instance_count      = 1
+    execution_role      = aws_iam_role.test.arn
+
+    lifecycle_config {
+      source_s3_uri = "s3://${aws_s3_bucket.test.id}/lifecycle/"
+      on_create     = "on_create.sh"
+    }
+  }
+
+  instance_group {
+    instance_group_name = "worker"
+    instance_type       = "ml.t3.medium"
+    instance_count      = 1
+    execution_role      = aws_iam_role.test.arn
+
+    lifecycle_config {
+      source_s3_uri = "s3://${aws_s3_bucket.test.id}/lifecycle/"
+      on_create     = "on_create.sh"
+    }
+
+    instance_storage_config {
+      ebs_volume_config {
+        volume_size_in_gb = 100
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_s3_object.test]
+}
+`, rName))
+}
+
+func testAccClusterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_cluster" "test" {
+  cluster_name = %[1]q
+
+  instance_group {
+    instance_group_name = "controller"
+    instance_type       = "ml.t3.medium"
+    instance_count      = 1
+    execution_role      = aws_iam_role.test.arn
+
+    lifecycle_config {
+      source_s3_uri = "s3://${aws_s3_bucket.test.id}/lifecycle/"
+      on_create     = "on_create.sh"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_s3_object.test]
+}
+`, rName, tagKey1, tagValue1))
+}
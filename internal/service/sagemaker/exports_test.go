@@ -7,6 +7,7 @@ package sagemaker
 var (
 	ResourceApp                                    = resourceApp
 	ResourceAppImageConfig                         = resourceAppImageConfig
+	ResourceCluster                                = resourceCluster
 	ResourceCodeRepository                         = resourceCodeRepository
 	ResourceDataQualityJobDefinition               = resourceDataQualityJobDefinition
 	ResourceDevice                                 = resourceDevice
@@ -20,6 +21,7 @@ var (
 	ResourceHumanTaskUI                            = resourceHumanTaskUI
 	ResourceImage                                  = resourceImage
 	ResourceImageVersion                           = resourceImageVersion
+	ResourceInferenceComponent                     = resourceInferenceComponent
 	ResourceMlflowTrackingServer                   = resourceMlflowTrackingServer
 	ResourceModel                                  = resourceModel
 	ResourceModelPackageGroup                      = resourceModelPackageGroup
@@ -37,6 +39,7 @@ var (
 
 	FindAppByName                             = findAppByName
 	FindAppImageConfigByName                  = findAppImageConfigByName
+	FindClusterByName                         = findClusterByName
 	FindCodeRepositoryByName                  = findCodeRepositoryByName
 	FindDataQualityJobDefinitionByName        = findDataQualityJobDefinitionByName
 	FindDeviceByName                          = findDeviceByName
@@ -50,6 +53,7 @@ var (
 	FindHumanTaskUIByName                     = findHumanTaskUIByName
 	FindImageByName                           = findImageByName
 	FindImageVersionByName                    = findImageVersionByName
+	FindInferenceComponentByName              = findInferenceComponentByName
 	FindMlflowTrackingServerByName            = findMlflowTrackingServerByName
 	FindModelByName                           = findModelByName
 	FindModelPackageGroupByName               = findModelPackageGroupByName
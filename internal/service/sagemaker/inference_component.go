@@ -0,0 +1,546 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sagemaker_inference_component", name="Inference Component")
+// @Tags(identifierAttribute="arn")
+func resourceInferenceComponent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInferenceComponentCreate,
+		ReadWithoutTimeout:   resourceInferenceComponentRead,
+		UpdateWithoutTimeout: resourceInferenceComponentUpdate,
+		DeleteWithoutTimeout: resourceInferenceComponentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"runtime_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"copy_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_inference_component_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validName,
+						},
+						"compute_resource_requirements": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_memory_required_in_mb": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(128),
+									},
+									"min_memory_required_in_mb": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(128),
+									},
+									"number_of_accelerator_devices_required": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(1),
+									},
+									"number_of_cpu_cores_required": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(0.25),
+									},
+								},
+							},
+						},
+						"container": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"artifact_url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									names.AttrEnvironment: {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"image": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"model_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"startup_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_startup_health_check_timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 3600),
+									},
+									"model_data_download_timeout_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(60, 3600),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"variant_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+		},
+	}
+}
+
+func resourceInferenceComponentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &sagemaker.CreateInferenceComponentInput{
+		EndpointName:           aws.String(d.Get("endpoint_name").(string)),
+		InferenceComponentName: aws.String(name),
+		Specification:          expandInferenceComponentSpecification(d.Get("specification").([]any)),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("runtime_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.RuntimeConfig = expandInferenceComponentRuntimeConfig(v.([]any))
+	}
+
+	if v, ok := d.GetOk("variant_name"); ok {
+		input.VariantName = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateInferenceComponent(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker AI Inference Component (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitInferenceComponentInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker AI Inference Component (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceInferenceComponentRead(ctx, d, meta)...)
+}
+
+func resourceInferenceComponentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	output, err := findInferenceComponentByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker AI Inference Component (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker AI Inference Component (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.InferenceComponentArn)
+	d.Set("endpoint_name", output.EndpointName)
+	d.Set(names.AttrName, output.InferenceComponentName)
+	if err := d.Set("runtime_config", flattenInferenceComponentRuntimeConfig(output.RuntimeConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting runtime_config: %s", err)
+	}
+	if err := d.Set("specification", flattenInferenceComponentSpecification(output.Specification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting specification: %s", err)
+	}
+	d.Set("variant_name", output.VariantName)
+
+	return diags
+}
+
+func resourceInferenceComponentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	// Copy count changes go through the lightweight runtime configuration API so that
+	// scaling an inference component doesn't redeploy its model containers.
+	if d.HasChange("specification") {
+		input := &sagemaker.UpdateInferenceComponentInput{
+			InferenceComponentName: aws.String(d.Id()),
+			Specification:          expandInferenceComponentSpecification(d.Get("specification").([]any)),
+		}
+
+		if d.HasChange("runtime_config") {
+			input.RuntimeConfig = expandInferenceComponentRuntimeConfig(d.Get("runtime_config").([]any))
+		}
+
+		_, err := conn.UpdateInferenceComponent(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker AI Inference Component (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitInferenceComponentInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker AI Inference Component (%s) update: %s", d.Id(), err)
+		}
+	} else if d.HasChange("runtime_config") {
+		input := &sagemaker.UpdateInferenceComponentRuntimeConfigInput{
+			DesiredRuntimeConfig:   expandInferenceComponentRuntimeConfig(d.Get("runtime_config").([]any)),
+			InferenceComponentName: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateInferenceComponentRuntimeConfig(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker AI Inference Component (%s) runtime config: %s", d.Id(), err)
+		}
+
+		if _, err := waitInferenceComponentInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker AI Inference Component (%s) runtime config update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInferenceComponentRead(ctx, d, meta)...)
+}
+
+func resourceInferenceComponentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
+
+	log.Printf("[INFO] Deleting SageMaker AI Inference Component: %s", d.Id())
+	_, err := conn.DeleteInferenceComponent(ctx, &sagemaker.DeleteInferenceComponentInput{
+		InferenceComponentName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationException, "Could not find inference component") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker AI Inference Component (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitInferenceComponentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker AI Inference Component (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findInferenceComponentByName(ctx context.Context, conn *sagemaker.Client, name string) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	input := &sagemaker.DescribeInferenceComponentInput{
+		InferenceComponentName: aws.String(name),
+	}
+
+	output, err := conn.DescribeInferenceComponent(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationException, "Could not find inference component") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusInferenceComponent(ctx context.Context, conn *sagemaker.Client, name string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findInferenceComponentByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.InferenceComponentStatus), nil
+	}
+}
+
+func waitInferenceComponentInService(ctx context.Context, conn *sagemaker.Client, name string, timeout time.Duration) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.InferenceComponentStatusCreating, awstypes.InferenceComponentStatusUpdating),
+		Target:  enum.Slice(awstypes.InferenceComponentStatusInService),
+		Refresh: statusInferenceComponent(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeInferenceComponentOutput); ok {
+		if failureReason := output.FailureReason; failureReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(failureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInferenceComponentDeleted(ctx context.Context, conn *sagemaker.Client, name string, timeout time.Duration) (*sagemaker.DescribeInferenceComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.InferenceComponentStatusDeleting),
+		Target:  []string{},
+		Refresh: statusInferenceComponent(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeInferenceComponentOutput); ok {
+		if failureReason := output.FailureReason; failureReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(failureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandInferenceComponentRuntimeConfig(tfList []any) *awstypes.InferenceComponentRuntimeConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+
+	return &awstypes.InferenceComponentRuntimeConfig{
+		CopyCount: aws.Int32(int32(tfMap["copy_count"].(int))),
+	}
+}
+
+func expandInferenceComponentSpecification(tfList []any) *awstypes.InferenceComponentSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+	apiObject := &awstypes.InferenceComponentSpecification{}
+
+	if v, ok := tfMap["base_inference_component_name"].(string); ok && v != "" {
+		apiObject.BaseInferenceComponentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["compute_resource_requirements"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ComputeResourceRequirements = expandInferenceComponentComputeResourceRequirements(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["container"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Container = expandInferenceComponentContainerSpecification(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["model_name"].(string); ok && v != "" {
+		apiObject.ModelName = aws.String(v)
+	}
+
+	if v, ok := tfMap["startup_parameters"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StartupParameters = expandInferenceComponentStartupParameters(v[0].(map[string]any))
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentComputeResourceRequirements(tfMap map[string]any) *awstypes.InferenceComponentComputeResourceRequirements {
+	apiObject := &awstypes.InferenceComponentComputeResourceRequirements{}
+
+	if v, ok := tfMap["max_memory_required_in_mb"].(int); ok && v != 0 {
+		apiObject.MaxMemoryRequiredInMb = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["min_memory_required_in_mb"].(int); ok && v != 0 {
+		apiObject.MinMemoryRequiredInMb = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["number_of_accelerator_devices_required"].(float64); ok && v != 0 {
+		apiObject.NumberOfAcceleratorDevicesRequired = aws.Float32(float32(v))
+	}
+
+	if v, ok := tfMap["number_of_cpu_cores_required"].(float64); ok && v != 0 {
+		apiObject.NumberOfCpuCoresRequired = aws.Float32(float32(v))
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentContainerSpecification(tfMap map[string]any) *awstypes.InferenceComponentContainerSpecification {
+	apiObject := &awstypes.InferenceComponentContainerSpecification{}
+
+	if v, ok := tfMap["artifact_url"].(string); ok && v != "" {
+		apiObject.ArtifactUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrEnvironment].(map[string]any); ok && len(v) > 0 {
+		apiObject.Environment = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["image"].(string); ok && v != "" {
+		apiObject.Image = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInferenceComponentStartupParameters(tfMap map[string]any) *awstypes.InferenceComponentStartupParameters {
+	apiObject := &awstypes.InferenceComponentStartupParameters{}
+
+	if v, ok := tfMap["container_startup_health_check_timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.ContainerStartupHealthCheckTimeoutInSeconds = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["model_data_download_timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.ModelDataDownloadTimeoutInSeconds = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenInferenceComponentRuntimeConfig(apiObject *awstypes.InferenceComponentRuntimeConfigSummary) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"copy_count": aws.ToInt32(apiObject.DesiredCopyCount),
+	}
+
+	return []any{tfMap}
+}
+
+func flattenInferenceComponentSpecification(apiObject *awstypes.InferenceComponentSpecificationSummary) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"base_inference_component_name": aws.ToString(apiObject.BaseInferenceComponentName),
+		"model_name":                    aws.ToString(apiObject.ModelName),
+	}
+
+	if v := apiObject.ComputeResourceRequirements; v != nil {
+		tfMap["compute_resource_requirements"] = []any{map[string]any{
+			"max_memory_required_in_mb":              aws.ToInt32(v.MaxMemoryRequiredInMb),
+			"min_memory_required_in_mb":              aws.ToInt32(v.MinMemoryRequiredInMb),
+			"number_of_accelerator_devices_required": aws.ToFloat32(v.NumberOfAcceleratorDevicesRequired),
+			"number_of_cpu_cores_required":           aws.ToFloat32(v.NumberOfCpuCoresRequired),
+		}}
+	}
+
+	if v := apiObject.Container; v != nil {
+		container := map[string]any{
+			"artifact_url":        aws.ToString(v.ArtifactUrl),
+			names.AttrEnvironment: v.Environment,
+		}
+
+		if v := v.DeployedImage; v != nil {
+			container["image"] = aws.ToString(v.SpecifiedImage)
+		}
+
+		tfMap["container"] = []any{container}
+	}
+
+	if v := apiObject.StartupParameters; v != nil {
+		tfMap["startup_parameters"] = []any{map[string]any{
+			"container_startup_health_check_timeout_in_seconds": aws.ToInt32(v.ContainerStartupHealthCheckTimeoutInSeconds),
+			"model_data_download_timeout_in_seconds":            aws.ToInt32(v.ModelDataDownloadTimeoutInSeconds),
+		}}
+	}
+
+	return []any{tfMap}
+}
@@ -7,4 +7,6 @@ package scheduler
 var (
 	FindScheduleByTwoPartKey = findScheduleByTwoPartKey
 	ResourceSchedule         = resourceSchedule
+	WaitScheduleGroupActive  = waitScheduleGroupActive
+	WaitScheduleGroupDeleted = waitScheduleGroupDeleted
 )
@@ -0,0 +1,23 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+status "ScheduleGroup" {
+  finder    = "findScheduleGroupByName"
+  arguments = ["name string"]
+  output    = "GetScheduleGroupOutput"
+  path      = "State"
+}
+
+waiter "ScheduleGroupActive" {
+  status                      = "ScheduleGroup"
+  pending                     = []
+  target                      = ["ACTIVE"]
+  not_found_checks            = 20
+  continuous_target_occurence = 2
+}
+
+waiter "ScheduleGroupDeleted" {
+  status  = "ScheduleGroup"
+  pending = ["DELETING", "ACTIVE"]
+  target  = []
+}
@@ -1,5 +1,4 @@
-// Copyright (c) HashiCorp, Inc.
-// SPDX-License-Identifier: MPL-2.0
+// Code generated by "internal/generate/waiters/main.go"; DO NOT EDIT.
 
 package scheduler
 
@@ -8,13 +7,32 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/scheduler/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func statusScheduleGroup(ctx context.Context, conn *scheduler.Client, name string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findScheduleGroupByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
 func waitScheduleGroupActive(ctx context.Context, conn *scheduler.Client, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   []string{},
-		Target:                    []string{scheduleGroupStatusActive},
+		Target:                    enum.Slice(awstypes.ScheduleGroupStateActive),
 		Refresh:                   statusScheduleGroup(ctx, conn, name),
 		Timeout:                   timeout,
 		NotFoundChecks:            20,
@@ -22,8 +40,9 @@ func waitScheduleGroupActive(ctx context.Context, conn *scheduler.Client, name s
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
-	if out, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
-		return out, err
+
+	if output, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
+		return output, err
 	}
 
 	return nil, err
@@ -31,15 +50,16 @@ func waitScheduleGroupActive(ctx context.Context, conn *scheduler.Client, name s
 
 func waitScheduleGroupDeleted(ctx context.Context, conn *scheduler.Client, name string, timeout time.Duration) (*scheduler.GetScheduleGroupOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{scheduleGroupStatusDeleting, scheduleGroupStatusActive},
+		Pending: enum.Slice(awstypes.ScheduleGroupStateActive, awstypes.ScheduleGroupStateDeleting),
 		Target:  []string{},
 		Refresh: statusScheduleGroup(ctx, conn, name),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
-	if out, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
-		return out, err
+
+	if output, ok := outputRaw.(*scheduler.GetScheduleGroupOutput); ok {
+		return output, err
 	}
 
 	return nil, err
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/aws/smithy-go/middleware"
+	tfscheduler "github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
+)
+
+type getScheduleGroupResult struct {
+	output *scheduler.GetScheduleGroupOutput
+	err    error
+}
+
+// fakeClient returns a client whose GetScheduleGroup calls return the specified results in order.
+// No requests are sent.
+func fakeClient(results ...getScheduleGroupResult) *scheduler.Client {
+	return scheduler.New(scheduler.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				return stack.Initialize.Add(
+					middleware.InitializeMiddlewareFunc(
+						"Test: Fake GetScheduleGroup",
+						func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+							if len(results) == 0 {
+								return middleware.InitializeOutput{}, middleware.Metadata{}, errors.New("unexpected GetScheduleGroup call")
+							}
+
+							result := results[0]
+							results = results[1:]
+
+							return middleware.InitializeOutput{Result: result.output}, middleware.Metadata{}, result.err
+						},
+					),
+					middleware.Before,
+				)
+			},
+		},
+	})
+}
+
+func scheduleGroupOutput(state awstypes.ScheduleGroupState) getScheduleGroupResult {
+	return getScheduleGroupResult{
+		output: &scheduler.GetScheduleGroupOutput{
+			Arn:   aws.String("arn:aws:scheduler:us-west-2:123456789012:schedule-group/test"), //lintignore:AWSAT003,AWSAT005
+			Name:  aws.String("test"),
+			State: state,
+		},
+	}
+}
+
+func TestWaitScheduleGroupActive(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		results       []getScheduleGroupResult
+		expectedError bool
+	}{
+		"active": {
+			results: []getScheduleGroupResult{
+				scheduleGroupOutput(awstypes.ScheduleGroupStateActive),
+				scheduleGroupOutput(awstypes.ScheduleGroupStateActive),
+			},
+		},
+		"deleting": {
+			results: []getScheduleGroupResult{
+				scheduleGroupOutput(awstypes.ScheduleGroupStateDeleting),
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := fakeClient(test.results...)
+
+			output, err := tfscheduler.WaitScheduleGroupActive(ctx, conn, "test", 1*time.Minute)
+
+			if test.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := output.State, awstypes.ScheduleGroupStateActive; got != want {
+				t.Errorf("state = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitScheduleGroupDeleted(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := fakeClient(
+		scheduleGroupOutput(awstypes.ScheduleGroupStateDeleting),
+		getScheduleGroupResult{err: &awstypes.ResourceNotFoundException{Message: aws.String("not found")}},
+	)
+
+	if _, err := tfscheduler.WaitScheduleGroupDeleted(ctx, conn, "test", 1*time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
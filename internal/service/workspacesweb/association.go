@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	associationIDParts = 2
+)
+
+// associationType describes how settings of type T are associated with a portal.
+// A portal has at most one settings resource of each type.
+type associationType[T any] struct {
+	// name is the settings type name used in messages, e.g. "Browser Settings".
+	name string
+	// settingsARNAttribute is the name of the settings ARN attribute, e.g. "browser_settings_arn".
+	settingsARNAttribute string
+	associate            func(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) error
+	disassociate         func(ctx context.Context, conn *workspacesweb.Client, portalARN string) error
+	findByARN            func(ctx context.Context, conn *workspacesweb.Client, arn string) (*T, error)
+	associatedPortalARNs func(*T) []string
+}
+
+func (t associationType[T]) findByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) (*T, error) {
+	output, err := t.findByARN(ctx, conn, settingsARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(t.associatedPortalARNs(output), portalARN) {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return output, nil
+}
+
+func newAssociationResource[T any](t associationType[T]) *associationResource[T] {
+	return &associationResource[T]{
+		associationType: t,
+	}
+}
+
+// associationResource is the implementation shared by all settings association resources.
+type associationResource[T any] struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	associationType[T]
+}
+
+func (r *associationResource[T]) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			r.settingsARNAttribute: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"portal_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *associationResource[T]) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	settingsARN, portalARN, diags := r.getARNs(ctx, request.Plan.GetAttribute)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	// The portal may still be processing a previous association change.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, associationConflictTimeout, func() (any, error) {
+		return nil, r.associate(ctx, conn, settingsARN, portalARN)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating WorkSpaces Web %s Association (%s,%s)", r.name, settingsARN, portalARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(r.settingsARNAttribute), settingsARN)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("portal_arn"), portalARN)...)
+}
+
+func (r *associationResource[T]) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	settingsARN, portalARN, diags := r.getARNs(ctx, request.State.GetAttribute)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := r.findByTwoPartKey(ctx, conn, settingsARN, portalARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web %s Association (%s,%s)", r.name, settingsARN, portalARN), err.Error())
+
+		return
+	}
+}
+
+func (r *associationResource[T]) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	settingsARN, portalARN, diags := r.getARNs(ctx, request.State.GetAttribute)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	// Disassociation is rejected while another association change on the portal is in progress.
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, associationConflictTimeout, func() (any, error) {
+		return nil, r.disassociate(ctx, conn, portalARN)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web %s Association (%s,%s)", r.name, settingsARN, portalARN), err.Error())
+
+		return
+	}
+}
+
+func (r *associationResource[T]) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, associationIDParts, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s,portal_arn. Got: %q", r.settingsARNAttribute, request.ID),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(r.settingsARNAttribute), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("portal_arn"), parts[1])...)
+}
+
+// getARNs returns the settings and portal ARNs from a plan or state.
+func (r *associationResource[T]) getARNs(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) (string, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var settingsARN, portalARN fwtypes.ARN
+
+	diags.Append(getAttribute(ctx, path.Root(r.settingsARNAttribute), &settingsARN)...)
+	diags.Append(getAttribute(ctx, path.Root("portal_arn"), &portalARN)...)
+
+	return settingsARN.ValueString(), portalARN.ValueString(), diags
+}
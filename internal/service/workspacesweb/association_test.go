@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// testAccAssociationType describes an association resource under test.
+// The resource type is "aws_workspacesweb_<settingsType>_association" and the settings
+// resource "aws_workspacesweb_<settingsType>" exports the "<settingsType>_arn" attribute.
+type testAccAssociationType struct {
+	settingsType   string
+	settingsConfig func(t *testing.T, rName string) string
+	find           func(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) error
+}
+
+func (at testAccAssociationType) resourceType() string {
+	return "aws_workspacesweb_" + at.settingsType + "_association"
+}
+
+func (at testAccAssociationType) settingsARNAttribute() string {
+	return at.settingsType + "_arn"
+}
+
+func testAccFindAssociation[T any](find func(context.Context, *workspacesweb.Client, string, string) (*T, error)) func(context.Context, *workspacesweb.Client, string, string) error {
+	return func(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) error {
+		_, err := find(ctx, conn, settingsARN, portalARN)
+
+		return err
+	}
+}
+
+var (
+	testAccBrowserSettingsAssociationType = testAccAssociationType{
+		settingsType: "browser_settings",
+		settingsConfig: func(*testing.T, string) string {
+			return testAccBrowserSettingsConfig_basic()
+		},
+		find: testAccFindAssociation(tfworkspacesweb.FindBrowserSettingsAssociationByTwoPartKey),
+	}
+	testAccIPAccessSettingsAssociationType = testAccAssociationType{
+		settingsType: "ip_access_settings",
+		settingsConfig: func(_ *testing.T, rName string) string {
+			return testAccIPAccessSettingsConfig_basic(rName)
+		},
+		find: testAccFindAssociation(tfworkspacesweb.FindIPAccessSettingsAssociationByTwoPartKey),
+	}
+	testAccNetworkSettingsAssociationType = testAccAssociationType{
+		settingsType: "network_settings",
+		settingsConfig: func(_ *testing.T, rName string) string {
+			return testAccNetworkSettingsConfig_basic(rName)
+		},
+		find: testAccFindAssociation(tfworkspacesweb.FindNetworkSettingsAssociationByTwoPartKey),
+	}
+	testAccTrustStoreAssociationType = testAccAssociationType{
+		settingsType: "trust_store",
+		settingsConfig: func(t *testing.T, rName string) string {
+			key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+			certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, acctest.RandomDomain().String())
+
+			return testAccTrustStoreConfig_basic(rName, certificate)
+		},
+		find: testAccFindAssociation(tfworkspacesweb.FindTrustStoreAssociationByTwoPartKey),
+	}
+	testAccUserAccessLoggingSettingsAssociationType = testAccAssociationType{
+		settingsType: "user_access_logging_settings",
+		settingsConfig: func(_ *testing.T, rName string) string {
+			return testAccUserAccessLoggingSettingsConfig_basic(rName)
+		},
+		find: testAccFindAssociation(tfworkspacesweb.FindUserAccessLoggingSettingsAssociationByTwoPartKey),
+	}
+	testAccUserSettingsAssociationType = testAccAssociationType{
+		settingsType: "user_settings",
+		settingsConfig: func(*testing.T, string) string {
+			return testAccUserSettingsConfig_basic()
+		},
+		find: testAccFindAssociation(tfworkspacesweb.FindUserSettingsAssociationByTwoPartKey),
+	}
+)
+
+// TestAccWorkSpacesWebAssociation_basic checks what differs between the association resources:
+// the resource and attribute names, the import identifier and the associate and disassociate calls.
+func TestAccWorkSpacesWebAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	testCases := map[string]testAccAssociationType{
+		"browser_settings":             testAccBrowserSettingsAssociationType,
+		"ip_access_settings":           testAccIPAccessSettingsAssociationType,
+		"network_settings":             testAccNetworkSettingsAssociationType,
+		"trust_store":                  testAccTrustStoreAssociationType,
+		"user_access_logging_settings": testAccUserAccessLoggingSettingsAssociationType,
+		"user_settings":                testAccUserSettingsAssociationType,
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+			resourceName := testCase.resourceType() + ".test"
+			settingsARNAttribute := testCase.settingsARNAttribute()
+
+			resource.ParallelTest(t, resource.TestCase{
+				PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+				ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				CheckDestroy:             testAccCheckAssociationDestroy(ctx, testCase),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccAssociationConfig_basic(t, testCase, rName),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							testAccCheckAssociationExists(ctx, testCase, resourceName),
+							resource.TestCheckResourceAttrPair(resourceName, settingsARNAttribute, "aws_workspacesweb_"+testCase.settingsType+".test", settingsARNAttribute),
+							resource.TestCheckResourceAttrPair(resourceName, "portal_arn", "aws_workspacesweb_portal.test", "portal_arn"),
+						),
+					},
+					{
+						ResourceName:                         resourceName,
+						ImportState:                          true,
+						ImportStateIdFunc:                    testAccAssociationImportStateIDFunc(testCase, resourceName),
+						ImportStateVerify:                    true,
+						ImportStateVerifyIdentifierAttribute: settingsARNAttribute,
+					},
+				},
+			})
+		})
+	}
+}
+
+// The create, read and delete logic is shared by all association resources, so one type is representative.
+func TestAccWorkSpacesWebAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testCase := testAccBrowserSettingsAssociationType
+	resourceName := testCase.resourceType() + ".test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssociationDestroy(ctx, testCase),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_basic(t, testCase, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, testCase, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceBrowserSettingsAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssociationDestroy(ctx context.Context, at testAccAssociationType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != at.resourceType() {
+				continue
+			}
+
+			settingsARN, portalARN := rs.Primary.Attributes[at.settingsARNAttribute()], rs.Primary.Attributes["portal_arn"]
+			err := at.find(ctx, conn, settingsARN, portalARN)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("%s %s,%s still exists", at.resourceType(), settingsARN, portalARN)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAssociationExists(ctx context.Context, at testAccAssociationType, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		return at.find(ctx, conn, rs.Primary.Attributes[at.settingsARNAttribute()], rs.Primary.Attributes["portal_arn"])
+	}
+}
+
+func testAccAssociationImportStateIDFunc(at testAccAssociationType, n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes[at.settingsARNAttribute()] + "," + rs.Primary.Attributes["portal_arn"], nil
+	}
+}
+
+func testAccAssociationConfig_basic(t *testing.T, at testAccAssociationType, rName string) string {
+	return acctest.ConfigCompose(at.settingsConfig(t, rName), fmt.Sprintf(`
+resource "aws_workspacesweb_portal" "test" {
+  display_name = %[1]q
+}
+
+resource %[2]q "test" {
+  portal_arn = aws_workspacesweb_portal.test.portal_arn
+
+  %[3]s = aws_workspacesweb_%[4]s.test.%[3]s
+}
+`, rName, at.resourceType(), at.settingsARNAttribute(), at.settingsType))
+}
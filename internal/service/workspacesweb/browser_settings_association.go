@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// @FrameworkResource("aws_workspacesweb_browser_settings_association", name="Browser Settings Association")
+func newBrowserSettingsAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return newAssociationResource(browserSettingsAssociation), nil
+}
+
+var browserSettingsAssociation = associationType[awstypes.BrowserSettings]{
+	name:                 "Browser Settings",
+	settingsARNAttribute: "browser_settings_arn",
+	associate: func(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) error {
+		_, err := conn.AssociateBrowserSettings(ctx, &workspacesweb.AssociateBrowserSettingsInput{
+			BrowserSettingsArn: aws.String(settingsARN),
+			PortalArn:          aws.String(portalARN),
+		})
+		return err
+	},
+	disassociate: func(ctx context.Context, conn *workspacesweb.Client, portalARN string) error {
+		_, err := conn.DisassociateBrowserSettings(ctx, &workspacesweb.DisassociateBrowserSettingsInput{
+			PortalArn: aws.String(portalARN),
+		})
+		return err
+	},
+	findByARN: findBrowserSettingsByARN,
+	associatedPortalARNs: func(v *awstypes.BrowserSettings) []string {
+		return v.AssociatedPortalArns
+	},
+}
+
+func findBrowserSettingsAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, browserSettingsARN, portalARN string) (*awstypes.BrowserSettings, error) {
+	return browserSettingsAssociation.findByTwoPartKey(ctx, conn, browserSettingsARN, portalARN)
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"time"
+)
+
+const (
+	// Portal associations are updated asynchronously; dependent operations
+	// fail with ConflictException until the update completes.
+	associationConflictTimeout = 5 * time.Minute
+)
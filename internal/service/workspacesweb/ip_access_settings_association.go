@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// @FrameworkResource("aws_workspacesweb_ip_access_settings_association", name="IP Access Settings Association")
+func newIPAccessSettingsAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return newAssociationResource(ipAccessSettingsAssociation), nil
+}
+
+var ipAccessSettingsAssociation = associationType[awstypes.IpAccessSettings]{
+	name:                 "IP Access Settings",
+	settingsARNAttribute: "ip_access_settings_arn",
+	associate: func(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) error {
+		_, err := conn.AssociateIpAccessSettings(ctx, &workspacesweb.AssociateIpAccessSettingsInput{
+			IpAccessSettingsArn: aws.String(settingsARN),
+			PortalArn:           aws.String(portalARN),
+		})
+		return err
+	},
+	disassociate: func(ctx context.Context, conn *workspacesweb.Client, portalARN string) error {
+		_, err := conn.DisassociateIpAccessSettings(ctx, &workspacesweb.DisassociateIpAccessSettingsInput{
+			PortalArn: aws.String(portalARN),
+		})
+		return err
+	},
+	findByARN: findIPAccessSettingsByARN,
+	associatedPortalARNs: func(v *awstypes.IpAccessSettings) []string {
+		return v.AssociatedPortalArns
+	},
+}
+
+func findIPAccessSettingsAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, ipAccessSettingsARN, portalARN string) (*awstypes.IpAccessSettings, error) {
+	return ipAccessSettingsAssociation.findByTwoPartKey(ctx, conn, ipAccessSettingsARN, portalARN)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// @FrameworkResource("aws_workspacesweb_network_settings_association", name="Network Settings Association")
+func newNetworkSettingsAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return newAssociationResource(networkSettingsAssociation), nil
+}
+
+var networkSettingsAssociation = associationType[awstypes.NetworkSettings]{
+	name:                 "Network Settings",
+	settingsARNAttribute: "network_settings_arn",
+	associate: func(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) error {
+		_, err := conn.AssociateNetworkSettings(ctx, &workspacesweb.AssociateNetworkSettingsInput{
+			NetworkSettingsArn: aws.String(settingsARN),
+			PortalArn:          aws.String(portalARN),
+		})
+		return err
+	},
+	disassociate: func(ctx context.Context, conn *workspacesweb.Client, portalARN string) error {
+		_, err := conn.DisassociateNetworkSettings(ctx, &workspacesweb.DisassociateNetworkSettingsInput{
+			PortalArn: aws.String(portalARN),
+		})
+		return err
+	},
+	findByARN: findNetworkSettingsByARN,
+	associatedPortalARNs: func(v *awstypes.NetworkSettings) []string {
+		return v.AssociatedPortalArns
+	},
+}
+
+func findNetworkSettingsAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, networkSettingsARN, portalARN string) (*awstypes.NetworkSettings, error) {
+	return networkSettingsAssociation.findByTwoPartKey(ctx, conn, networkSettingsARN, portalARN)
+}
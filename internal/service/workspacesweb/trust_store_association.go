@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// @FrameworkResource("aws_workspacesweb_trust_store_association", name="Trust Store Association")
+func newTrustStoreAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return newAssociationResource(trustStoreAssociation), nil
+}
+
+var trustStoreAssociation = associationType[awstypes.TrustStore]{
+	name:                 "Trust Store",
+	settingsARNAttribute: "trust_store_arn",
+	associate: func(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) error {
+		_, err := conn.AssociateTrustStore(ctx, &workspacesweb.AssociateTrustStoreInput{
+			TrustStoreArn: aws.String(settingsARN),
+			PortalArn:     aws.String(portalARN),
+		})
+		return err
+	},
+	disassociate: func(ctx context.Context, conn *workspacesweb.Client, portalARN string) error {
+		_, err := conn.DisassociateTrustStore(ctx, &workspacesweb.DisassociateTrustStoreInput{
+			PortalArn: aws.String(portalARN),
+		})
+		return err
+	},
+	findByARN: findTrustStoreByARN,
+	associatedPortalARNs: func(v *awstypes.TrustStore) []string {
+		return v.AssociatedPortalArns
+	},
+}
+
+func findTrustStoreAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, trustStoreARN, portalARN string) (*awstypes.TrustStore, error) {
+	return trustStoreAssociation.findByTwoPartKey(ctx, conn, trustStoreARN, portalARN)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// @FrameworkResource("aws_workspacesweb_user_access_logging_settings_association", name="User Access Logging Settings Association")
+func newUserAccessLoggingSettingsAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return newAssociationResource(userAccessLoggingSettingsAssociation), nil
+}
+
+var userAccessLoggingSettingsAssociation = associationType[awstypes.UserAccessLoggingSettings]{
+	name:                 "User Access Logging Settings",
+	settingsARNAttribute: "user_access_logging_settings_arn",
+	associate: func(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) error {
+		_, err := conn.AssociateUserAccessLoggingSettings(ctx, &workspacesweb.AssociateUserAccessLoggingSettingsInput{
+			UserAccessLoggingSettingsArn: aws.String(settingsARN),
+			PortalArn:                    aws.String(portalARN),
+		})
+		return err
+	},
+	disassociate: func(ctx context.Context, conn *workspacesweb.Client, portalARN string) error {
+		_, err := conn.DisassociateUserAccessLoggingSettings(ctx, &workspacesweb.DisassociateUserAccessLoggingSettingsInput{
+			PortalArn: aws.String(portalARN),
+		})
+		return err
+	},
+	findByARN: findUserAccessLoggingSettingsByARN,
+	associatedPortalARNs: func(v *awstypes.UserAccessLoggingSettings) []string {
+		return v.AssociatedPortalArns
+	},
+}
+
+func findUserAccessLoggingSettingsAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, userAccessLoggingSettingsARN, portalARN string) (*awstypes.UserAccessLoggingSettings, error) {
+	return userAccessLoggingSettingsAssociation.findByTwoPartKey(ctx, conn, userAccessLoggingSettingsARN, portalARN)
+}
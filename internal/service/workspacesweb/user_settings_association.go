@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// @FrameworkResource("aws_workspacesweb_user_settings_association", name="User Settings Association")
+func newUserSettingsAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return newAssociationResource(userSettingsAssociation), nil
+}
+
+var userSettingsAssociation = associationType[awstypes.UserSettings]{
+	name:                 "User Settings",
+	settingsARNAttribute: "user_settings_arn",
+	associate: func(ctx context.Context, conn *workspacesweb.Client, settingsARN, portalARN string) error {
+		_, err := conn.AssociateUserSettings(ctx, &workspacesweb.AssociateUserSettingsInput{
+			UserSettingsArn: aws.String(settingsARN),
+			PortalArn:       aws.String(portalARN),
+		})
+		return err
+	},
+	disassociate: func(ctx context.Context, conn *workspacesweb.Client, portalARN string) error {
+		_, err := conn.DisassociateUserSettings(ctx, &workspacesweb.DisassociateUserSettingsInput{
+			PortalArn: aws.String(portalARN),
+		})
+		return err
+	},
+	findByARN: findUserSettingsByARN,
+	associatedPortalARNs: func(v *awstypes.UserSettings) []string {
+		return v.AssociatedPortalArns
+	},
+}
+
+func findUserSettingsAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, userSettingsARN, portalARN string) (*awstypes.UserSettings, error) {
+	return userSettingsAssociation.findByTwoPartKey(ctx, conn, userSettingsARN, portalARN)
+}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"maps"
 	"net/url"
+	"path/filepath"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -53,12 +55,30 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// IncludeResourceTypes and ExcludeResourceTypes restrict the resource types that Tags apply to.
+	// Elements are resource type name patterns, e.g. "aws_s3_bucket*", as accepted by filepath.Match.
+	IncludeResourceTypes []string
+	ExcludeResourceTypes []string
+	// ResourceTypeTags contains additional tags to default across matching resource types.
+	ResourceTypeTags []ResourceTypeTags
+	// PropagateToChildResourceTypes contains resource type name patterns whose resources' child resources are also tagged.
+	PropagateToChildResourceTypes []string
+}
+
+// ResourceTypeTags contains tags to default across resources whose type name matches any of ResourceTypes.
+type ResourceTypeTags struct {
+	ResourceTypes []string
+	Tags          KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	// KeyPatterns contains regular expressions matching tag keys to ignore.
+	KeyPatterns []*regexp.Regexp
+	// KeyValues contains tags to ignore only when both key and value match.
+	KeyValues KeyValueTags
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -87,6 +107,60 @@ func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	return dc.Tags.Merge(tags)
 }
 
+// ForResourceType returns the DefaultConfig that applies to the specified resource type, e.g. "aws_s3_bucket".
+// Tags are omitted if the resource type is excluded, or not included when IncludeResourceTypes is set,
+// and the tags of any matching ResourceTypeTags are merged in, overriding the value of any tag with a matching key.
+func (dc *DefaultConfig) ForResourceType(typeName string) *DefaultConfig {
+	if dc == nil || typeName == "" {
+		return dc
+	}
+
+	if len(dc.IncludeResourceTypes) == 0 && len(dc.ExcludeResourceTypes) == 0 && len(dc.ResourceTypeTags) == 0 {
+		return dc
+	}
+
+	var tags KeyValueTags
+	if (len(dc.IncludeResourceTypes) == 0 || matchResourceType(dc.IncludeResourceTypes, typeName)) && !matchResourceType(dc.ExcludeResourceTypes, typeName) {
+		tags = dc.Tags
+	}
+
+	for _, v := range dc.ResourceTypeTags {
+		if matchResourceType(v.ResourceTypes, typeName) {
+			if tags == nil {
+				tags = v.Tags
+			} else {
+				tags = tags.Merge(v.Tags)
+			}
+		}
+	}
+
+	if tags == nil {
+		return nil
+	}
+
+	return &DefaultConfig{
+		Tags:                          tags,
+		PropagateToChildResourceTypes: dc.PropagateToChildResourceTypes,
+	}
+}
+
+// PropagateToChildResources returns whether the default tags are propagated to the child resources of the specified resource type.
+func (dc *DefaultConfig) PropagateToChildResources(typeName string) bool {
+	if dc == nil || len(dc.Tags) == 0 {
+		return false
+	}
+
+	return matchResourceType(dc.PropagateToChildResourceTypes, typeName)
+}
+
+// matchResourceType returns whether the resource type name matches any of the specified patterns.
+func matchResourceType(patterns []string, typeName string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		ok, err := filepath.Match(pattern, typeName)
+		return err == nil && ok
+	})
+}
+
 // TagsEqual returns true if the given configuration's Tags
 // are equal to those passed in as an argument;
 // otherwise returns false
@@ -127,6 +201,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnorePatterns(config.KeyPatterns)
+	result = result.IgnoreKeyValues(config.KeyValues)
 
 	return result
 }
@@ -178,6 +254,38 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnorePatterns returns tags whose keys don't match any of the regular expressions.
+func (tags KeyValueTags) IgnorePatterns(patterns []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(patterns, func(re *regexp.Regexp) bool {
+			return re.MatchString(k)
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreKeyValues returns tags not matching both the key and value of a given tag.
+func (tags KeyValueTags) IgnoreKeyValues(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if ignoreTag, ok := ignoreTags[k]; ok && v.ValueString() == ignoreTag.ValueString() {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -5,9 +5,11 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"slices"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -57,6 +59,232 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          map[string]string
+	}{
+		{
+			name:          "no config",
+			defaultConfig: nil,
+			typeName:      "aws_s3_bucket",
+			want:          nil,
+		},
+		{
+			name: "no filters",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			typeName: "aws_s3_bucket",
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+			},
+			typeName: "aws_autoscaling_group",
+			want:     nil,
+		},
+		{
+			name: "not excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeResourceTypes: []string{"aws_autoscaling_group"},
+			},
+			typeName: "aws_s3_bucket",
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "included",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				IncludeResourceTypes: []string{"aws_s3_*"},
+			},
+			typeName: "aws_s3_bucket",
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "not included",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				IncludeResourceTypes: []string{"aws_s3_*"},
+			},
+			typeName: "aws_vpc",
+			want:     nil,
+		},
+		{
+			name: "included and excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				IncludeResourceTypes: []string{"aws_s3_*"},
+				ExcludeResourceTypes: []string{"aws_s3_bucket_policy"},
+			},
+			typeName: "aws_s3_bucket_policy",
+			want:     nil,
+		},
+		{
+			name: "resource type tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+				ResourceTypeTags: []ResourceTypeTags{
+					{
+						ResourceTypes: []string{"aws_s3_bucket*"},
+						Tags: New(ctx, map[string]string{
+							"key2": "override2",
+							"key3": "value3",
+						}),
+					},
+					{
+						ResourceTypes: []string{"aws_vpc"},
+						Tags: New(ctx, map[string]string{
+							"key4": "value4",
+						}),
+					},
+				},
+			},
+			typeName: "aws_s3_bucket_versioning",
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "override2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "resource type tags excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeResourceTypes: []string{"aws_s3_bucket"},
+				ResourceTypeTags: []ResourceTypeTags{
+					{
+						ResourceTypes: []string{"aws_s3_bucket"},
+						Tags: New(ctx, map[string]string{
+							"key2": "value2",
+						}),
+					},
+				},
+			},
+			typeName: "aws_s3_bucket",
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName)
+
+			if testCase.want == nil {
+				if got != nil {
+					t.Errorf("got %v, want nil", got.Tags.Map())
+				}
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsDefaultConfigPropagateToChildResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          bool
+	}{
+		{
+			name:     "no config",
+			typeName: "aws_instance",
+		},
+		{
+			name: "no tags",
+			defaultConfig: &DefaultConfig{
+				PropagateToChildResourceTypes: []string{"aws_instance"},
+			},
+			typeName: "aws_instance",
+		},
+		{
+			name: "not propagated",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			typeName: "aws_instance",
+		},
+		{
+			name: "propagated",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				PropagateToChildResourceTypes: []string{"aws_autoscaling_group", "aws_instance"},
+			},
+			typeName: "aws_instance",
+			want:     true,
+		},
+		{
+			name: "resource type excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeResourceTypes:          []string{"aws_instance"},
+				PropagateToChildResourceTypes: []string{"aws_instance"},
+			},
+			typeName: "aws_instance",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResourceType(testCase.typeName).PropagateToChildResources(testCase.typeName)
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -565,6 +793,69 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key patterns",
+			tags: New(ctx, map[string]string{
+				"key1":           "value1",
+				"scanner:run-01": "value2",
+				"scanner:run-02": "value3",
+				"cost:center":    "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexache.MustCompile(`^scanner:run-\d+$`),
+					regexache.MustCompile(`center$`),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "key values",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyValues: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "other",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "all",
+			tags: New(ctx, map[string]string{
+				"key1":    "value1",
+				"key2":    "value2",
+				"key3":    "value3",
+				"prefix4": "value4",
+				"match5":  "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(ctx, []string{
+					"key1",
+				}),
+				KeyPrefixes: New(ctx, []string{
+					"prefix",
+				}),
+				KeyPatterns: []*regexp.Regexp{
+					regexache.MustCompile(`^match\d$`),
+				},
+				KeyValues: New(ctx, map[string]string{
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -11,9 +11,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -25,63 +25,33 @@ type Retryable func(error) (bool, error)
 // RetryWhen retries the function `f` when the error it returns satisfies `retryable`.
 // `f` is retried until `timeout` expires.
 func RetryWhen(ctx context.Context, timeout time.Duration, f func() (any, error), retryable Retryable) (any, error) {
-	var output any
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-		var again bool
-
-		output, err = f()
-		again, err = retryable(err)
-
-		if again {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if TimedOut(err) {
-		output, err = f()
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return RetryGWhen(ctx, timeout, f, retryable)
 }
 
 // RetryGWhen is the generic version of RetryWhen which obviates the need for a type
 // assertion after the call. It retries the function `f` when the error it returns
 // satisfies `retryable`. `f` is retried until `timeout` expires.
 func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T, error), retryable Retryable) (T, error) {
-	var output T
+	return runOperation(ctx, timeout, f, func(_ T, err error) (bool, error) {
+		return retryable(err)
+	})
+}
 
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
+// runOperation retries the function `f` while `predicate` indicates, until `timeout` expires.
+// If `f` is still being retried when `timeout` expires, `f` is called one final time and its result returned.
+// If `ctx` is done first, the last error is returned.
+func runOperation[T any](ctx context.Context, timeout time.Duration, f func() (T, error), predicate tfretry.PredicateFunc[T]) (T, error) {
+	output, err := tfretry.Operation(func(context.Context) (T, error) {
+		return f()
+	}).If(predicate).Run(ctx, timeout)
+
+	if timeoutErr, ok := errs.As[*tfretry.TimeoutError](err); ok {
 		var again bool
 
 		output, err = f()
-		again, err = retryable(err)
-
-		if again {
-			return retry.RetryableError(err)
+		if again, err = predicate(output, err); again && err == nil {
+			err = timeoutErr
 		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if TimedOut(err) {
-		output, err = f()
 	}
 
 	if err != nil {
@@ -92,144 +62,76 @@ func RetryGWhen[T any](ctx context.Context, timeout time.Duration, f func() (T,
 	return output, nil
 }
 
-// RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
-func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f func() (any, error), codes ...string) (any, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrCodeEquals(err, codes...) {
+// retryableWhen returns a Retryable that retries errors matching any of the specified predicates.
+func retryableWhen(predicates ...tfretry.ErrorPredicate) Retryable {
+	predicate := tfretry.Or(predicates...)
+
+	return func(err error) (bool, error) {
+		if err != nil && predicate(err) {
 			return true, err
 		}
 
 		return false, err
-	})
+	}
+}
+
+// RetryWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
+func RetryWhenAWSErrCodeEquals(ctx context.Context, timeout time.Duration, f func() (any, error), codes ...string) (any, error) { // nosemgrep:ci.aws-in-func-name
+	return RetryWhen(ctx, timeout, f, retryableWhen(tfretry.ErrorCodeEquals(codes...)))
 }
 
 // RetryGWhenAWSErrCodeEquals retries the specified function when it returns one of the specified AWS error codes.
 func RetryGWhenAWSErrCodeEquals[T any](ctx context.Context, timeout time.Duration, f func() (T, error), codes ...string) (T, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrCodeEquals(err, codes...) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return RetryGWhen(ctx, timeout, f, retryableWhen(tfretry.ErrorCodeEquals(codes...)))
 }
 
 // RetryWhenAWSErrCodeContains retries the specified function when it returns an AWS error containing the specified code.
 func RetryWhenAWSErrCodeContains(ctx context.Context, timeout time.Duration, f func() (any, error), code string) (any, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrCodeContains(err, code) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return RetryWhen(ctx, timeout, f, retryableWhen(tfretry.ErrorCodeContains(code)))
 }
 
 // RetryWhenAWSErrMessageContains retries the specified function when it returns an AWS error containing the specified message.
 func RetryWhenAWSErrMessageContains(ctx context.Context, timeout time.Duration, f func() (any, error), code, message string) (any, error) { // nosemgrep:ci.aws-in-func-name
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if tfawserr.ErrMessageContains(err, code, message) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return RetryWhen(ctx, timeout, f, retryableWhen(tfretry.ErrorMessageContains(code, message)))
 }
 
 func RetryWhenIsA[T error](ctx context.Context, timeout time.Duration, f func() (any, error)) (any, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return RetryWhen(ctx, timeout, f, retryableWhen(tfretry.IsA[T]()))
 }
 
 func RetryWhenIsOneOf2[T1, T2 error](ctx context.Context, timeout time.Duration, f func() (any, error)) (any, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T1](err) || errs.IsA[T2](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return RetryWhen(ctx, timeout, f, retryableWhen(tfretry.IsA[T1](), tfretry.IsA[T2]()))
 }
 
 func RetryWhenIsOneOf3[T1, T2, T3 error](ctx context.Context, timeout time.Duration, f func() (any, error)) (any, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T1](err) || errs.IsA[T2](err) || errs.IsA[T3](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return RetryWhen(ctx, timeout, f, retryableWhen(tfretry.IsA[T1](), tfretry.IsA[T2](), tfretry.IsA[T3]()))
 }
 
 func RetryWhenIsOneOf4[T1, T2, T3, T4 error](ctx context.Context, timeout time.Duration, f func() (any, error)) (any, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsA[T1](err) || errs.IsA[T2](err) || errs.IsA[T3](err) || errs.IsA[T4](err) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return RetryWhen(ctx, timeout, f, retryableWhen(tfretry.IsA[T1](), tfretry.IsA[T2](), tfretry.IsA[T3](), tfretry.IsA[T4]()))
 }
 
 func RetryWhenIsAErrorMessageContains[T errs.ErrorWithErrorMessage](ctx context.Context, timeout time.Duration, f func() (any, error), needle string) (any, error) {
-	return RetryWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsAErrorMessageContains[T](err, needle) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return RetryWhen(ctx, timeout, f, retryableWhen(tfretry.IsAErrorMessageContains[T](needle)))
 }
 
 func RetryGWhenIsAErrorMessageContains[T any, E errs.ErrorWithErrorMessage](ctx context.Context, timeout time.Duration, f func() (T, error), needle string) (T, error) {
-	return RetryGWhen(ctx, timeout, f, func(err error) (bool, error) {
-		if errs.IsAErrorMessageContains[E](err, needle) {
-			return true, err
-		}
-
-		return false, err
-	})
+	return RetryGWhen(ctx, timeout, f, retryableWhen(tfretry.IsAErrorMessageContains[E](needle)))
 }
 
 // RetryUntilEqual retries the specified function until it returns a value equal to `t`.
 func RetryUntilEqual[T comparable](ctx context.Context, timeout time.Duration, t T, f func() (T, error)) (T, error) {
-	var output T
-
-	err := Retry(ctx, timeout, func() *retry.RetryError {
-		var err error
-
-		output, err = f()
-
+	return runOperation(ctx, timeout, f, func(output T, err error) (bool, error) {
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return false, err
 		}
 
 		if output != t {
-			return retry.RetryableError(fmt.Errorf("output = %v, want %v", output, t))
+			return true, fmt.Errorf("output = %v, want %v", output, t)
 		}
 
-		return nil
+		return false, nil
 	})
-
-	if TimedOut(err) {
-		output, err = f()
-
-		if err == nil && output != t {
-			err = fmt.Errorf("output = %v, want %v", output, t)
-		}
-	}
-
-	if err != nil {
-		var zero T
-		return zero, err
-	}
-
-	return output, nil
 }
 
 var ErrFoundResource = errors.New(`found resource`)
@@ -4,6 +4,7 @@
 package tfresource_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync/atomic"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -290,6 +292,120 @@ func TestRetryUntilNotFound(t *testing.T) { //nolint:tparallel
 	}
 }
 
+func TestRetryWhen_timeout(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	errRetryable := errors.New("retryable")
+	retryable := func(err error) (bool, error) {
+		if errors.Is(err, errRetryable) {
+			return true, err
+		}
+
+		return false, err
+	}
+
+	t.Run("final attempt succeeds", func(t *testing.T) {
+		t.Parallel()
+
+		const timeout = 1 * time.Second
+		deadline := time.Now().Add(timeout)
+
+		var attempts int32
+		got, err := tfresource.RetryWhen(ctx, timeout, func() (any, error) {
+			atomic.AddInt32(&attempts, 1)
+			if time.Now().Before(deadline) {
+				return nil, errRetryable
+			}
+			return "final", nil
+		}, retryable)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got != "final" {
+			t.Errorf("output = %v, want %q", got, "final")
+		}
+		if atomic.LoadInt32(&attempts) < 2 {
+			t.Errorf("attempts = %d, want at least 2", attempts)
+		}
+	})
+
+	t.Run("final attempt fails", func(t *testing.T) {
+		t.Parallel()
+
+		var finalAttempt error = &retry.NotFoundError{Message: "final"}
+		const timeout = 1 * time.Second
+		deadline := time.Now().Add(timeout)
+
+		_, err := tfresource.RetryWhen(ctx, timeout, func() (any, error) {
+			if time.Now().Before(deadline) {
+				return nil, errRetryable
+			}
+			return nil, finalAttempt
+		}, retryable)
+
+		if err != finalAttempt { //nolint:errorlint // We are actually comparing equality
+			t.Fatalf("error = %v, want %v", err, finalAttempt)
+		}
+	})
+
+	t.Run("still retryable", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := tfresource.RetryWhen(ctx, 1*time.Second, func() (any, error) {
+			return nil, errRetryable
+		}, retryable)
+
+		if !errors.Is(err, errRetryable) {
+			t.Fatalf("error = %v, want %v", err, errRetryable)
+		}
+		if tfretry.TimedOut(err) {
+			t.Errorf("error = %v, want last attempt's error", err)
+		}
+	})
+
+	t.Run("context done", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(ctx, 1*time.Second)
+		defer cancel()
+
+		var attempts int32
+		_, err := tfresource.RetryWhen(ctx, 1*time.Hour, func() (any, error) {
+			atomic.AddInt32(&attempts, 1)
+			return nil, errRetryable
+		}, retryable)
+
+		if !errors.Is(err, errRetryable) {
+			t.Fatalf("error = %v, want %v", err, errRetryable)
+		}
+		if tfretry.TimedOut(err) {
+			t.Errorf("error = %v, want last error", err)
+		}
+
+		// No final attempt is made once the caller's context is done.
+		n := atomic.LoadInt32(&attempts)
+		time.Sleep(100 * time.Millisecond)
+		if got := atomic.LoadInt32(&attempts); got != n {
+			t.Errorf("attempts = %d after return, want %d", got, n)
+		}
+	})
+}
+
+func TestRetryUntilNotFound_timeout(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	_, err := tfresource.RetryUntilNotFound(ctx, 1*time.Second, func() (any, error) {
+		return nil, nil
+	})
+
+	if !errors.Is(err, tfresource.ErrFoundResource) {
+		t.Fatalf("error = %v, want %v", err, tfresource.ErrFoundResource)
+	}
+}
+
 func TestRetryContext_error(t *testing.T) {
 	ctx := acctest.Context(t)
 	t.Parallel()
@@ -18,6 +18,17 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageTagPropagation represents the propagation of provider default tags from a parent resource
+// to the child resources that AWS creates on its behalf, e.g. the EBS volumes of an EC2 instance.
+type ServicePackageTagPropagation struct {
+	TypeName                string // Parent resource type name, e.g. "aws_instance"
+	ChildResourceName       string // Friendly child resource name, e.g. "EBS Volume"
+	ChildServicePackageName string // Service package whose UpdateTags method is used to tag child resources
+	ChildResourceType       string // Extra resourceType parameter value for the child service package's UpdateTags
+	// ListChildren returns the identifiers of the child resources of the parent resource with the specified ID.
+	ListChildren func(ctx context.Context, meta any, id string) ([]string, error)
+}
+
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
 // implemented by a service package.
 type ServicePackageEphemeralResource struct {